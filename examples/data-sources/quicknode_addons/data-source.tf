@@ -0,0 +1,4 @@
+# gets all add-ons available for ethereum
+data "quicknode_addons" "ethereum" {
+  chain = "eth"
+}
@@ -0,0 +1,22 @@
+# alerts on USDC transfers received by a wallet
+data "quicknode_alert_expression" "usdc_received" {
+  template  = "erc20_transfer"
+  contract  = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+  addresses = ["0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"]
+  direction = "to"
+}
+
+# alerts on any Approval event of a contract
+data "quicknode_alert_expression" "approvals" {
+  template        = "contract_event"
+  contract        = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+  event_signature = "Approval(address,address,uint256)"
+}
+
+resource "quicknode_notification" "usdc_received" {
+  name            = "usdc-received"
+  network         = "ethereum-mainnet"
+  expression      = data.quicknode_alert_expression.usdc_received.expression
+  destination_ids = [quicknode_destination.webhook.id]
+  enabled         = true
+}
@@ -0,0 +1,9 @@
+# gets all networks supported for ethereum
+data "quicknode_chains" "ethereum" {
+  chain = "eth"
+}
+
+# the networks quicknode_notification.network accepts for ethereum
+output "ethereum_quickalerts_networks" {
+  value = data.quicknode_chains.ethereum.chains[*].quickalerts_network
+}
@@ -1,2 +1,9 @@
 # List all destinations.
 data "quicknode_destinations" "all" {}
+
+# List the destinations of a team.
+data "quicknode_destinations" "payments" {
+  labels = {
+    team = "payments"
+  }
+}
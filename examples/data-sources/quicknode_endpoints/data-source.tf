@@ -0,0 +1,7 @@
+# gets all active ethereum mainnet endpoints labeled for production
+data "quicknode_endpoints" "production" {
+  chain       = "eth"
+  network     = "mainnet"
+  label_regex = "^prod-"
+  status      = "active"
+}
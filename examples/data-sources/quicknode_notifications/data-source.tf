@@ -1,2 +1,9 @@
 # List all notifications.
-data "quicknode_notifications" "all" {}
\ No newline at end of file
+data "quicknode_notifications" "all" {}
+
+# List the notifications of a team.
+data "quicknode_notifications" "payments" {
+  labels = {
+    team = "payments"
+  }
+}
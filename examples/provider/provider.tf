@@ -3,3 +3,31 @@ provider "quicknode" {
   host  = "https://api.quicknode.com"
   token = "TOKEN_VALUE"
 }
+
+# Retry rate limited and failed requests up to 5 times, waiting at most 60
+# seconds between two attempts, and send at most 5 requests per second
+provider "quicknode" {
+  alias = "throttled"
+
+  host                = "https://api.quicknode.com"
+  token               = "TOKEN_VALUE"
+  max_retries         = 5
+  retry_max_wait      = 60
+  requests_per_second = 5
+}
+
+# Label every resource that supports labels with its owner. Most QuickNode APIs
+# have no tags, so labels are encoded at the end of names or descriptions. IPFS
+# pins keep them in their metadata.
+provider "quicknode" {
+  alias = "labeled"
+
+  host  = "https://api.quicknode.com"
+  token = "TOKEN_VALUE"
+
+  default_labels = {
+    team        = "payments"
+    environment = "prod"
+    cost-center = "cc-1234"
+  }
+}
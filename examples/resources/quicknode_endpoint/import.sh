@@ -0,0 +1,2 @@
+# Endpoint can be imported by specifying the ID in API.
+terraform import quicknode_endpoint.endpoint $ENDPOINT_ID
@@ -0,0 +1,7 @@
+# creates an ethereum mainnet endpoint
+resource "quicknode_endpoint" "endpoint" {
+  chain   = "eth"
+  network = "mainnet"
+  label   = var.endpoint_label
+  status  = "active"
+}
@@ -0,0 +1,2 @@
+# Add-on can be imported by specifying the endpoint ID and the add-on slug.
+terraform import quicknode_endpoint_addon.trace $ENDPOINT_ID/trace
@@ -0,0 +1,5 @@
+# enables the trace API on the endpoint
+resource "quicknode_endpoint_addon" "trace" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+  slug        = "trace"
+}
@@ -0,0 +1,2 @@
+# Domain mask can be imported by specifying the endpoint ID and the domain mask ID in API.
+terraform import quicknode_endpoint_domain_mask.domain_mask $ENDPOINT_ID/$DOMAIN_MASK_ID
@@ -0,0 +1,5 @@
+# serves the endpoint from a custom domain
+resource "quicknode_endpoint_domain_mask" "domain_mask" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+  domain_mask = "rpc.example.com"
+}
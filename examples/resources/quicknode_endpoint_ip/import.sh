@@ -0,0 +1,2 @@
+# IP can be imported by specifying the endpoint ID and the IP ID in API.
+terraform import quicknode_endpoint_ip.ip $ENDPOINT_ID/$IP_ID
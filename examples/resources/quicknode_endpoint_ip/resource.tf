@@ -0,0 +1,5 @@
+# only allows requests from the given IP address
+resource "quicknode_endpoint_ip" "ip" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+  ip          = "203.0.113.10"
+}
@@ -0,0 +1,2 @@
+# JWT can be imported by specifying the endpoint ID and the JWT ID in API.
+terraform import quicknode_endpoint_jwt.jwt $ENDPOINT_ID/$JWT_ID
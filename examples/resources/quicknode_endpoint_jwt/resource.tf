@@ -0,0 +1,7 @@
+# requires requests to the endpoint to be signed with the matching private key
+resource "quicknode_endpoint_jwt" "jwt" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+  name        = "signing-key-2024"
+  kid         = "signing-key-2024"
+  public_key  = file("${path.module}/signing-key.pub.pem")
+}
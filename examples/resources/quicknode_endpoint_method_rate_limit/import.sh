@@ -0,0 +1,2 @@
+# Method rate limit can be imported by specifying the endpoint ID and the method rate limit ID in API.
+terraform import quicknode_endpoint_method_rate_limit.get_logs $ENDPOINT_ID/$METHOD_RATE_LIMIT_ID
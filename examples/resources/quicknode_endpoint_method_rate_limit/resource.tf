@@ -0,0 +1,7 @@
+# limits the expensive log queries separately
+resource "quicknode_endpoint_method_rate_limit" "get_logs" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+  interval    = "second"
+  methods     = ["eth_getLogs"]
+  rate        = 5
+}
@@ -0,0 +1,2 @@
+# Rate limits can be imported by specifying the endpoint ID in API.
+terraform import quicknode_endpoint_rate_limits.limits $ENDPOINT_ID
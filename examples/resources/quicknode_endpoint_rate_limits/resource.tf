@@ -0,0 +1,6 @@
+# caps the credit burn of the endpoint
+resource "quicknode_endpoint_rate_limits" "limits" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+  rps         = 50
+  rpd         = 1000000
+}
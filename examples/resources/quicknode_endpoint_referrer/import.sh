@@ -0,0 +1,2 @@
+# Referrer can be imported by specifying the endpoint ID and the referrer ID in API.
+terraform import quicknode_endpoint_referrer.referrer $ENDPOINT_ID/$REFERRER_ID
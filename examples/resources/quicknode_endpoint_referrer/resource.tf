@@ -0,0 +1,5 @@
+# only allows requests from the given referrer
+resource "quicknode_endpoint_referrer" "referrer" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+  referrer    = "app.example.com"
+}
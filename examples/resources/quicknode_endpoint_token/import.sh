@@ -0,0 +1,2 @@
+# Token can be imported by specifying the endpoint ID and the token ID in API.
+terraform import quicknode_endpoint_token.token $ENDPOINT_ID/$TOKEN_ID
@@ -0,0 +1,4 @@
+# creates an additional authentication token for the endpoint
+resource "quicknode_endpoint_token" "token" {
+  endpoint_id = resource.quicknode_endpoint.endpoint.id
+}
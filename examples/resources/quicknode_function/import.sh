@@ -0,0 +1,3 @@
+# Function can be imported by specifying the ID in API.
+# source_file is not known to the API and has to be added to the configuration.
+terraform import quicknode_function.decode $FUNCTION_ID
@@ -0,0 +1,17 @@
+resource "quicknode_function" "decode" {
+  name        = "decode-transfers"
+  description = "Decodes ERC20 transfers from block data"
+  runtime     = "nodejs:20"
+  source_file = "${path.module}/functions/decode.js"
+  timeout     = 30
+
+  environment = {
+    LOG_LEVEL = "info"
+  }
+}
+
+resource "quicknode_function" "hello" {
+  name    = "hello"
+  runtime = "nodejs:20"
+  code    = "function main(params) { return { message: 'hello' }; }"
+}
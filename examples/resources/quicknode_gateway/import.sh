@@ -1,2 +1,5 @@
-# Gateway can be imported by specifying the NAME in API.
-terraform import quicknode_gateway.gateway $GATEWAY_NAME
+# Gateway can be imported by specifying the ID in API.
+terraform import quicknode_gateway.gateway $GATEWAY_ID
+
+# or by specifying its name prefixed with "name:".
+terraform import quicknode_gateway.gateway name:$GATEWAY_NAME
@@ -3,4 +3,33 @@ resource "quicknode_gateway" "gateway" {
   name    = var.gateway_name
   private = true
   enabled = false
-}
\ No newline at end of file
+
+  # waits until the gateway is provisioned, bounded by the create and update
+  # timeouts, set to false to return as soon as the API accepts the request
+  wait_for_ready = true
+
+  # every resource accepts a timeouts block, the defaults are 10m for create,
+  # update and delete, and 5m for read
+  timeouts {
+    create = "15m"
+  }
+}
+
+# renaming a gateway replaces it, create_before_destroy keeps a gateway
+# available during the replacement
+resource "quicknode_gateway" "renamed" {
+  name    = "${var.gateway_name}-v2"
+  private = true
+  enabled = true
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+# replaces a gateway not managed by Terraform, private and enabled default to
+# its settings and it is deleted once the new gateway is ready
+resource "quicknode_gateway" "moved" {
+  name       = "${var.gateway_name}-moved"
+  moved_from = "legacy-gateway"
+}
@@ -0,0 +1,9 @@
+# uploads and pins a front-end build, a rebuild with new content replaces the upload
+resource "quicknode_ipfs_file" "site" {
+  source = "${path.module}/dist"
+  key    = "site"
+}
+
+output "index_cid" {
+  value = quicknode_ipfs_file.site.files["index.html"].cid
+}
@@ -0,0 +1,2 @@
+# IPFS pin can be imported by specifying the request ID in API.
+terraform import quicknode_ipfs_pin.logo $REQUEST_ID
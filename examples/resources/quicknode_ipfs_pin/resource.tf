@@ -0,0 +1,13 @@
+# pins content that is already on IPFS
+resource "quicknode_ipfs_pin" "logo" {
+  cid  = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+  name = "logo"
+
+  metadata = {
+    source = "design-system"
+  }
+
+  labels = {
+    team = "frontend"
+  }
+}
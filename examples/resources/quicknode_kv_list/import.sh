@@ -0,0 +1,2 @@
+# Key-value list can be imported by specifying the key.
+terraform import quicknode_kv_list.watched_wallets watched_wallets
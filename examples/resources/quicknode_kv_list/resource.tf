@@ -0,0 +1,8 @@
+# allowlist read by a Streams filter function
+resource "quicknode_kv_list" "watched_wallets" {
+  key   = "watched_wallets"
+  items = [
+    "0xd8da6bf26964af9d7eed9e03e53415d37aa96045",
+    "0xab5801a7d398351b8be11c439e05c5b3259aec9b",
+  ]
+}
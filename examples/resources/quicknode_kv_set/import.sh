@@ -0,0 +1,2 @@
+# Key-value set can be imported by specifying the key.
+terraform import quicknode_kv_set.min_value min_transfer_value
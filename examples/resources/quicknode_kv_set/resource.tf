@@ -0,0 +1,4 @@
+resource "quicknode_kv_set" "min_value" {
+  key   = "min_transfer_value"
+  value = "1000000000000000000"
+}
@@ -1,7 +1,42 @@
 resource "quicknode_notification" "notification" {
   name            = var.notification_name
   network         = "ethereum-mainnet"
-  expression      = "dHhfdG8gPT0gJzB4ZDhkYTZiZjI2OTY0YWY5ZDdlZWQ5ZTAzZTUzNDE1ZDM3YWE5NjA0Nic="
+  expression_raw  = "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'"
   destination_ids = [resource.quicknode_destination.destination.id]
   enabled         = true
-}
\ No newline at end of file
+
+  # merged with the default_labels of the provider and encoded at the end of
+  # the name as "<name> [environment=prod,team=payments]"
+  labels = {
+    team = "payments"
+  }
+}
+
+# ERC20 transfers to any of the watched wallets over 1000 tokens
+resource "quicknode_notification" "transfers" {
+  name            = "large-transfers"
+  network         = "ethereum-mainnet"
+  destination_ids = [resource.quicknode_destination.destination.id]
+  enabled         = true
+
+  condition {
+    all {
+      field = "tx_logs_topic0"
+      value = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+    }
+    all {
+      dynamic "any" {
+        for_each = var.watched_wallet_topics
+        content {
+          field = "tx_logs_topic2"
+          value = any.value
+        }
+      }
+    }
+    all {
+      field    = "tx_value"
+      operator = ">"
+      value    = "1000000000000000000000"
+    }
+  }
+}
@@ -0,0 +1,3 @@
+# Stream can be imported by specifying the ID in API.
+# The destination block is not returned by the API and has to be added to the configuration.
+terraform import quicknode_stream.transfers $STREAM_ID
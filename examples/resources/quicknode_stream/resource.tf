@@ -0,0 +1,40 @@
+# delivers the ERC20 transfer logs of every new block to a webhook
+resource "quicknode_stream" "transfers" {
+  name        = "erc20-transfers"
+  network     = "ethereum-mainnet"
+  dataset     = "logs"
+  start_range = 19000000
+  region      = "usa_east"
+  status      = "active"
+
+  filter_function = <<-EOT
+    function main(data) {
+      const transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef";
+      return data.filter((log) => log.topics[0] === transferTopic);
+    }
+  EOT
+
+  webhook {
+    url         = var.webhook_url
+    compression = "gzip"
+    max_retry   = 3
+  }
+}
+
+# writes every block to a PostgreSQL table
+resource "quicknode_stream" "blocks" {
+  name        = "blocks"
+  network     = "ethereum-mainnet"
+  dataset     = "block"
+  start_range = 19000000
+
+  postgres {
+    host       = var.postgres_host
+    port       = 5432
+    database   = "chain"
+    username   = "streams"
+    password   = var.postgres_password
+    table_name = "blocks"
+    sslmode    = "require"
+  }
+}
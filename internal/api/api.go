@@ -0,0 +1,71 @@
+// Package api contains clients for the QuickNode APIs that are not yet covered
+// by github.com/jmtx1020/go_quicknode. They reuse the same *client.APIWrapper
+// so authentication is configured once by the provider.
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/jmtx1020/go_quicknode/client"
+)
+
+// Error is returned when the QuickNode API responds with a non 2xx status code.
+type Error struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("error: %s", e.Body)
+}
+
+// IsNotFound reports whether err is an *Error with a 404 status code.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// Do sends a request to endpoint, JSON encoding payload when it is not nil and
+// decoding the response body into out when it is not nil.
+func Do(api *client.APIWrapper, method, endpoint string, payload, out any) error {
+	var reqBody io.Reader
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewBuffer(payloadBytes)
+	}
+
+	req, err := http.NewRequest(method, endpoint, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := api.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &Error{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jmtx1020/go_quicknode/client"
+)
+
+// WithContext returns a copy of wrapper whose requests are sent with ctx, so
+// they are cancelled when ctx is done. This also covers the go_quicknode
+// clients, whose calls do not take a context.
+func WithContext(ctx context.Context, wrapper *client.APIWrapper) *client.APIWrapper {
+	next := wrapper.Client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	httpClient := *wrapper.Client
+	httpClient.Transport = &contextTransport{ctx: ctx, next: next}
+	return &client.APIWrapper{Client: &httpClient, BaseURL: wrapper.BaseURL}
+}
+
+// contextTransport sends requests with ctx instead of their own context.
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(t.ctx))
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jmtx1020/go_quicknode/client"
+)
+
+func TestWithContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	wrapper := client.NewAPIWrapper("token", server.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	// requests built without a context are cancelled with ctx too
+	err := Do(WithContext(ctx, wrapper), http.MethodGet, server.URL, nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("request took %s, want it cancelled after 50ms", elapsed)
+	}
+	if _, ok := wrapper.Client.Transport.(*contextTransport); ok {
+		t.Errorf("the transport of wrapper should be left as is")
+	}
+}
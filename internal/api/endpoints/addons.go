@@ -0,0 +1,59 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const addonsURL = "https://api.quicknode.com/v0/addons"
+
+type Addon struct {
+	Slug        string   `json:"slug"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Chains      []string `json:"chains"`
+}
+
+type addonPayload struct {
+	Slug string `json:"slug"`
+}
+
+// GetAllAddons lists the marketplace add-ons available for chain, or all
+// add-ons when chain is empty.
+func (e *EndpointAPI) GetAllAddons(chain string) ([]Addon, error) {
+	endpoint := addonsURL
+	if chain != "" {
+		endpoint += "?chain=" + url.QueryEscape(chain)
+	}
+
+	var resp response[[]Addon]
+	if err := api.Do(e.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (e *EndpointAPI) GetEndpointAddons(endpointID string) ([]Addon, error) {
+	endpoint := fmt.Sprintf("%s/%s/addons", endpointsURL, endpointID)
+
+	var resp response[[]Addon]
+	if err := api.Do(e.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (e *EndpointAPI) AttachAddon(endpointID, slug string) error {
+	endpoint := fmt.Sprintf("%s/%s/addons", endpointsURL, endpointID)
+
+	return api.Do(e.API, http.MethodPost, endpoint, addonPayload{Slug: slug}, nil)
+}
+
+func (e *EndpointAPI) DetachAddon(endpointID, slug string) error {
+	endpoint := fmt.Sprintf("%s/%s/addons/%s", endpointsURL, endpointID, slug)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
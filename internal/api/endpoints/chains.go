@@ -0,0 +1,27 @@
+package endpoints
+
+import (
+	"net/http"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const chainsURL = "https://api.quicknode.com/v0/chains"
+
+type Chain struct {
+	Slug     string         `json:"slug"`
+	Networks []ChainNetwork `json:"networks"`
+}
+
+type ChainNetwork struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+func (e *EndpointAPI) GetAllChains() ([]Chain, error) {
+	var resp response[[]Chain]
+	if err := api.Do(e.API, http.MethodGet, chainsURL, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
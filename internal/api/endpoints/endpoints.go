@@ -0,0 +1,97 @@
+// Package endpoints is a client for the endpoint management routes of the
+// QuickNode Admin API.
+package endpoints
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const endpointsURL = "https://api.quicknode.com/v0/endpoints"
+
+type Endpoint struct {
+	ID      string `json:"id"`
+	Label   string `json:"label"`
+	Chain   string `json:"chain"`
+	Network string `json:"network"`
+	HTTPURL string `json:"http_url"`
+	WSSURL  string `json:"wss_url"`
+	Status  string `json:"status"`
+}
+
+type EndpointPayload struct {
+	Chain   string `json:"chain"`
+	Network string `json:"network"`
+}
+
+type EndpointUpdatePayload struct {
+	Label string `json:"label"`
+}
+
+type EndpointStatusPayload struct {
+	Status string `json:"status"`
+}
+
+// response is the envelope the Admin API wraps every payload in.
+type response[T any] struct {
+	Data T `json:"data"`
+}
+
+type EndpointAPI struct {
+	API *client.APIWrapper
+}
+
+func (e *EndpointAPI) CreateEndpoint(chain, network string) (*Endpoint, error) {
+	payload := EndpointPayload{
+		Chain:   chain,
+		Network: network,
+	}
+
+	var resp response[Endpoint]
+	if err := api.Do(e.API, http.MethodPost, endpointsURL, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) GetAllEndpoints() ([]Endpoint, error) {
+	var resp response[[]Endpoint]
+	if err := api.Do(e.API, http.MethodGet, endpointsURL, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (e *EndpointAPI) GetEndpointByID(id string) (*Endpoint, error) {
+	endpoint := fmt.Sprintf("%s/%s", endpointsURL, id)
+
+	var resp response[Endpoint]
+	if err := api.Do(e.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) UpdateEndpointByID(id, label string) error {
+	endpoint := fmt.Sprintf("%s/%s", endpointsURL, id)
+	payload := EndpointUpdatePayload{Label: label}
+
+	return api.Do(e.API, http.MethodPatch, endpoint, payload, nil)
+}
+
+func (e *EndpointAPI) UpdateEndpointStatusByID(id, status string) error {
+	endpoint := fmt.Sprintf("%s/%s/status", endpointsURL, id)
+	payload := EndpointStatusPayload{Status: status}
+
+	return api.Do(e.API, http.MethodPatch, endpoint, payload, nil)
+}
+
+func (e *EndpointAPI) DeleteEndpointByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", endpointsURL, id)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,95 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/http"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+// RateLimits are the request limits of an endpoint, nil fields are unlimited.
+type RateLimits struct {
+	RPS *int64 `json:"rps"`
+	RPM *int64 `json:"rpm"`
+	RPD *int64 `json:"rpd"`
+}
+
+type RateLimitsPayload struct {
+	RateLimits RateLimits `json:"rate_limits"`
+}
+
+type MethodRateLimit struct {
+	ID       string   `json:"id"`
+	Interval string   `json:"interval"`
+	Methods  []string `json:"methods"`
+	Rate     int64    `json:"rate"`
+	Status   string   `json:"status"`
+}
+
+type MethodRateLimitPayload struct {
+	Interval string   `json:"interval,omitempty"`
+	Methods  []string `json:"methods"`
+	Rate     int64    `json:"rate"`
+}
+
+type methodRateLimits struct {
+	RateLimiters []MethodRateLimit `json:"rate_limiters"`
+}
+
+func (e *EndpointAPI) GetRateLimits(endpointID string) (*RateLimits, error) {
+	endpoint := fmt.Sprintf("%s/%s/rate-limits", endpointsURL, endpointID)
+
+	var resp response[RateLimitsPayload]
+	if err := api.Do(e.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data.RateLimits, nil
+}
+
+func (e *EndpointAPI) UpdateRateLimits(endpointID string, rateLimits RateLimits) error {
+	endpoint := fmt.Sprintf("%s/%s/rate-limits", endpointsURL, endpointID)
+	payload := RateLimitsPayload{RateLimits: rateLimits}
+
+	return api.Do(e.API, http.MethodPut, endpoint, payload, nil)
+}
+
+func (e *EndpointAPI) GetMethodRateLimits(endpointID string) ([]MethodRateLimit, error) {
+	endpoint := fmt.Sprintf("%s/%s/method-rate-limits", endpointsURL, endpointID)
+
+	var resp response[methodRateLimits]
+	if err := api.Do(e.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data.RateLimiters, nil
+}
+
+func (e *EndpointAPI) CreateMethodRateLimit(endpointID, interval string, methods []string, rate int64) (*MethodRateLimit, error) {
+	endpoint := fmt.Sprintf("%s/%s/method-rate-limits", endpointsURL, endpointID)
+	payload := MethodRateLimitPayload{
+		Interval: interval,
+		Methods:  methods,
+		Rate:     rate,
+	}
+
+	var resp response[MethodRateLimit]
+	if err := api.Do(e.API, http.MethodPost, endpoint, payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) UpdateMethodRateLimit(endpointID, methodRateLimitID string, methods []string, rate int64) error {
+	endpoint := fmt.Sprintf("%s/%s/method-rate-limits/%s", endpointsURL, endpointID, methodRateLimitID)
+	payload := MethodRateLimitPayload{
+		Methods: methods,
+		Rate:    rate,
+	}
+
+	return api.Do(e.API, http.MethodPatch, endpoint, payload, nil)
+}
+
+func (e *EndpointAPI) DeleteMethodRateLimit(endpointID, methodRateLimitID string) error {
+	endpoint := fmt.Sprintf("%s/%s/method-rate-limits/%s", endpointsURL, endpointID, methodRateLimitID)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,158 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/http"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+type Token struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
+
+type Referrer struct {
+	ID       string `json:"id"`
+	Referrer string `json:"referrer"`
+}
+
+type IP struct {
+	ID string `json:"id"`
+	IP string `json:"ip"`
+}
+
+type DomainMask struct {
+	ID         string `json:"id"`
+	DomainMask string `json:"domain_mask"`
+}
+
+// Security holds every security setting of an endpoint.
+type Security struct {
+	Tokens      []Token      `json:"tokens"`
+	Referrers   []Referrer   `json:"referrers"`
+	IPs         []IP         `json:"ips"`
+	DomainMasks []DomainMask `json:"domain_masks"`
+	JWTs        []JWT        `json:"jwts"`
+}
+
+type ReferrerPayload struct {
+	Referrer string `json:"referrer"`
+}
+
+type IPPayload struct {
+	IP string `json:"ip"`
+}
+
+type DomainMaskPayload struct {
+	DomainMask string `json:"domain_mask"`
+}
+
+func securityURL(endpointID, kind string) string {
+	return fmt.Sprintf("%s/%s/security/%s", endpointsURL, endpointID, kind)
+}
+
+func (e *EndpointAPI) GetSecurityByEndpointID(endpointID string) (*Security, error) {
+	endpoint := fmt.Sprintf("%s/%s/security", endpointsURL, endpointID)
+
+	var resp response[Security]
+	if err := api.Do(e.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) CreateToken(endpointID string) (*Token, error) {
+	var resp response[Token]
+	if err := api.Do(e.API, http.MethodPost, securityURL(endpointID, "tokens"), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) DeleteToken(endpointID, tokenID string) error {
+	endpoint := fmt.Sprintf("%s/%s", securityURL(endpointID, "tokens"), tokenID)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
+
+func (e *EndpointAPI) CreateReferrer(endpointID, referrer string) (*Referrer, error) {
+	payload := ReferrerPayload{Referrer: referrer}
+
+	var resp response[Referrer]
+	if err := api.Do(e.API, http.MethodPost, securityURL(endpointID, "referrers"), payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) DeleteReferrer(endpointID, referrerID string) error {
+	endpoint := fmt.Sprintf("%s/%s", securityURL(endpointID, "referrers"), referrerID)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
+
+func (e *EndpointAPI) CreateIP(endpointID, ip string) (*IP, error) {
+	payload := IPPayload{IP: ip}
+
+	var resp response[IP]
+	if err := api.Do(e.API, http.MethodPost, securityURL(endpointID, "ips"), payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) DeleteIP(endpointID, ipID string) error {
+	endpoint := fmt.Sprintf("%s/%s", securityURL(endpointID, "ips"), ipID)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
+
+func (e *EndpointAPI) CreateDomainMask(endpointID, domainMask string) (*DomainMask, error) {
+	payload := DomainMaskPayload{DomainMask: domainMask}
+
+	var resp response[DomainMask]
+	if err := api.Do(e.API, http.MethodPost, securityURL(endpointID, "domain_masks"), payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) DeleteDomainMask(endpointID, domainMaskID string) error {
+	endpoint := fmt.Sprintf("%s/%s", securityURL(endpointID, "domain_masks"), domainMaskID)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
+
+type JWT struct {
+	ID        string `json:"id"`
+	PublicKey string `json:"public_key"`
+	KID       string `json:"kid"`
+	Name      string `json:"name"`
+}
+
+type JWTPayload struct {
+	PublicKey string `json:"public_key"`
+	KID       string `json:"kid"`
+	Name      string `json:"name"`
+}
+
+func (e *EndpointAPI) CreateJWT(endpointID, publicKey, kid, name string) (*JWT, error) {
+	payload := JWTPayload{
+		PublicKey: publicKey,
+		KID:       kid,
+		Name:      name,
+	}
+
+	var resp response[JWT]
+	if err := api.Do(e.API, http.MethodPost, securityURL(endpointID, "jwts"), payload, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (e *EndpointAPI) DeleteJWT(endpointID, jwtID string) error {
+	endpoint := fmt.Sprintf("%s/%s", securityURL(endpointID, "jwts"), jwtID)
+
+	return api.Do(e.API, http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,75 @@
+package endpoints
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jmtx1020/go_quicknode/client"
+)
+
+// cannedTransport answers every request with body and records the last
+// request body.
+type cannedTransport struct {
+	body        string
+	requestBody []byte
+}
+
+func (t *cannedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		t.requestBody = body
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewBufferString(t.body)),
+		Request:    req,
+	}, nil
+}
+
+func newTestEndpointAPI(transport http.RoundTripper) *EndpointAPI {
+	return &EndpointAPI{API: &client.APIWrapper{Client: &http.Client{Transport: transport}}}
+}
+
+func TestGetSecurityDomainMasks(t *testing.T) {
+	e := newTestEndpointAPI(&cannedTransport{body: `{"data":{"domain_masks":[{"id":"1","domain_mask":"rpc.example.com"}]}}`})
+
+	security, err := e.GetSecurityByEndpointID("endpoint")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(security.DomainMasks) != 1 {
+		t.Fatalf("got %d domain masks, want 1", len(security.DomainMasks))
+	}
+	if got := security.DomainMasks[0]; got.ID != "1" || got.DomainMask != "rpc.example.com" {
+		t.Errorf("domain mask = %+v, want ID 1 and domain mask rpc.example.com", got)
+	}
+}
+
+func TestCreateDomainMask(t *testing.T) {
+	transport := &cannedTransport{body: `{"data":{"id":"1","domain_mask":"rpc.example.com"}}`}
+	e := newTestEndpointAPI(transport)
+
+	domainMask, err := e.CreateDomainMask("endpoint", "rpc.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if domainMask.DomainMask != "rpc.example.com" {
+		t.Errorf("domain mask = %q, want rpc.example.com", domainMask.DomainMask)
+	}
+
+	// the created domain mask is read back with the field it was sent with
+	var payload map[string]string
+	if err := json.Unmarshal(transport.requestBody, &payload); err != nil {
+		t.Fatalf("unexpected request body %q: %s", transport.requestBody, err)
+	}
+	if payload["domain_mask"] != "rpc.example.com" {
+		t.Errorf("request body = %s, want the domain mask in domain_mask", transport.requestBody)
+	}
+}
@@ -0,0 +1,73 @@
+// Package functions is a client for the QuickNode Functions REST API.
+package functions
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const functionsURL = "https://api.quicknode.com/functions/rest/v1/functions"
+
+type Function struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Kind        string            `json:"kind"`
+	Code        string            `json:"code"`
+	Timeout     int64             `json:"timeout"`
+	Environment map[string]string `json:"environment"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type FunctionPayload struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Kind        string            `json:"kind"`
+	Code        string            `json:"code"`
+	Timeout     int64             `json:"timeout,omitempty"`
+	Environment map[string]string `json:"environment"`
+}
+
+type FunctionAPI struct {
+	API *client.APIWrapper
+}
+
+func (f *FunctionAPI) CreateFunction(payload FunctionPayload) (*Function, error) {
+	var function Function
+	if err := api.Do(f.API, http.MethodPost, functionsURL, payload, &function); err != nil {
+		return nil, err
+	}
+	return &function, nil
+}
+
+func (f *FunctionAPI) GetFunctionByID(id string) (*Function, error) {
+	endpoint := fmt.Sprintf("%s/%s", functionsURL, id)
+
+	var function Function
+	if err := api.Do(f.API, http.MethodGet, endpoint, nil, &function); err != nil {
+		return nil, err
+	}
+	return &function, nil
+}
+
+func (f *FunctionAPI) UpdateFunctionByID(id string, payload FunctionPayload) (*Function, error) {
+	endpoint := fmt.Sprintf("%s/%s", functionsURL, id)
+
+	var function Function
+	if err := api.Do(f.API, http.MethodPatch, endpoint, payload, &function); err != nil {
+		return nil, err
+	}
+	return &function, nil
+}
+
+func (f *FunctionAPI) DeleteFunctionByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", functionsURL, id)
+
+	return api.Do(f.API, http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,66 @@
+// Package ipfsgateway adds the IPFS gateway REST API calls of
+// github.com/jmtx1020/go_quicknode/api/ipfs/gateway that need to report the
+// status code of failed requests.
+package ipfsgateway
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/jmtx1020/go_quicknode/api/ipfs/gateway"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const gatewayURL = "https://api.quicknode.com/ipfs/rest/v1/gateway"
+
+type GatewayAPI struct {
+	API *client.APIWrapper
+}
+
+// GetGatewayByName returns the gateway, failing with an *api.Error that
+// carries the status code when it cannot be read.
+func (g *GatewayAPI) GetGatewayByName(name string) (*gateway.Gateway, error) {
+	endpoint := fmt.Sprintf("%s/%s", gatewayURL, url.PathEscape(name))
+
+	var gw gateway.Gateway
+	if err := api.Do(g.API, http.MethodGet, endpoint, nil, &gw); err != nil {
+		return nil, err
+	}
+	return &gw, nil
+}
+
+// GetGatewayByID returns the gateway with the given ID. The API has no
+// endpoint to read a gateway by ID, so it lists the gateways and fails with a
+// 404 *api.Error when none of them matches.
+func (g *GatewayAPI) GetGatewayByID(id string) (*gateway.Gateway, error) {
+	var gws []gateway.Gateway
+	if err := api.Do(g.API, http.MethodGet, gatewayURL, nil, &gws); err != nil {
+		return nil, err
+	}
+
+	for i := range gws {
+		if FormatID(gws[i].ID) == id {
+			return &gws[i], nil
+		}
+	}
+	return nil, &api.Error{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("gateway %s not found", id)}
+}
+
+// FormatID formats the ID of a gateway, which is decoded from JSON as a
+// float64, without an exponent.
+func FormatID(id any) string {
+	if f, ok := id.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", id)
+}
+
+// DeleteGatewayByName deletes the gateway.
+func (g *GatewayAPI) DeleteGatewayByName(name string) error {
+	endpoint := fmt.Sprintf("%s/%s", gatewayURL, url.PathEscape(name))
+	return api.Do(g.API, http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,95 @@
+// Package kv is a client for the QuickNode Key-Value Store REST API.
+package kv
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const kvURL = "https://api.quicknode.com/kv/rest/v1"
+
+type List struct {
+	Key   string   `json:"key"`
+	Items []string `json:"items"`
+}
+
+type ListUpdatePayload struct {
+	AddItems    []string `json:"addItems"`
+	RemoveItems []string `json:"removeItems"`
+}
+
+type Set struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type response[T any] struct {
+	Data T `json:"data"`
+}
+
+type KVAPI struct {
+	API *client.APIWrapper
+}
+
+func (k *KVAPI) CreateList(key string, items []string) error {
+	endpoint := fmt.Sprintf("%s/lists", kvURL)
+
+	return api.Do(k.API, http.MethodPost, endpoint, List{Key: key, Items: items}, nil)
+}
+
+func (k *KVAPI) GetList(key string) (*List, error) {
+	endpoint := fmt.Sprintf("%s/lists/%s", kvURL, url.PathEscape(key))
+
+	var resp response[List]
+	if err := api.Do(k.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	resp.Data.Key = key
+	return &resp.Data, nil
+}
+
+// UpdateList adds and removes items without uploading the whole list.
+func (k *KVAPI) UpdateList(key string, addItems, removeItems []string) error {
+	endpoint := fmt.Sprintf("%s/lists/%s", kvURL, url.PathEscape(key))
+
+	payload := ListUpdatePayload{
+		AddItems:    addItems,
+		RemoveItems: removeItems,
+	}
+	return api.Do(k.API, http.MethodPatch, endpoint, payload, nil)
+}
+
+func (k *KVAPI) DeleteList(key string) error {
+	endpoint := fmt.Sprintf("%s/lists/%s", kvURL, url.PathEscape(key))
+
+	return api.Do(k.API, http.MethodDelete, endpoint, nil, nil)
+}
+
+// PutSet creates the key or overwrites its value.
+func (k *KVAPI) PutSet(key, value string) error {
+	endpoint := fmt.Sprintf("%s/sets", kvURL)
+
+	return api.Do(k.API, http.MethodPost, endpoint, Set{Key: key, Value: value}, nil)
+}
+
+func (k *KVAPI) GetSet(key string) (*Set, error) {
+	endpoint := fmt.Sprintf("%s/sets/%s", kvURL, url.PathEscape(key))
+
+	var resp response[Set]
+	if err := api.Do(k.API, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	resp.Data.Key = key
+	return &resp.Data, nil
+}
+
+func (k *KVAPI) DeleteSet(key string) error {
+	endpoint := fmt.Sprintf("%s/sets/%s", kvURL, url.PathEscape(key))
+
+	return api.Do(k.API, http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,74 @@
+// Package pinning is a client for the QuickNode IPFS pinning REST API. Unlike
+// github.com/jmtx1020/go_quicknode/api/ipfs/pinning it accepts arbitrary
+// metadata and reports the status code of failed requests.
+package pinning
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	ipfs "github.com/jmtx1020/go_quicknode/api/ipfs/pinning"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const pinningURL = "https://api.quicknode.com/ipfs/rest/v1/pinning"
+
+type PinnedObject struct {
+	RequestID string            `json:"requestId"`
+	Status    string            `json:"status"`
+	CID       string            `json:"cid"`
+	Name      string            `json:"name"`
+	Origins   ipfs.Origins      `json:"origins"`
+	Meta      map[string]string `json:"meta"`
+	Size      string            `json:"size"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+type PinnedObjectPayload struct {
+	CID     string            `json:"cid,omitempty"`
+	Name    string            `json:"name"`
+	Origins []string          `json:"origins"`
+	Meta    map[string]string `json:"meta"`
+}
+
+type PinningAPI struct {
+	API *client.APIWrapper
+}
+
+func (p *PinningAPI) CreatePinnedObject(payload PinnedObjectPayload) (*PinnedObject, error) {
+	var pinnedObject PinnedObject
+	if err := api.Do(p.API, http.MethodPost, pinningURL, payload, &pinnedObject); err != nil {
+		return nil, err
+	}
+	return &pinnedObject, nil
+}
+
+func (p *PinningAPI) GetPinnedObjectByRequestID(requestID string) (*PinnedObject, error) {
+	endpoint := fmt.Sprintf("%s/%s", pinningURL, requestID)
+
+	var pinnedObject PinnedObject
+	if err := api.Do(p.API, http.MethodGet, endpoint, nil, &pinnedObject); err != nil {
+		return nil, err
+	}
+	return &pinnedObject, nil
+}
+
+func (p *PinningAPI) UpdatePinnedObject(requestID string, payload PinnedObjectPayload) (*PinnedObject, error) {
+	endpoint := fmt.Sprintf("%s/%s", pinningURL, requestID)
+
+	var pinnedObject PinnedObject
+	if err := api.Do(p.API, http.MethodPatch, endpoint, payload, &pinnedObject); err != nil {
+		return nil, err
+	}
+	return &pinnedObject, nil
+}
+
+func (p *PinningAPI) DeletePinnedObject(requestID string) error {
+	endpoint := fmt.Sprintf("%s/%s", pinningURL, requestID)
+
+	return api.Do(p.API, http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,49 @@
+// Package quickalerts adds the QuickAlerts REST API calls missing from
+// github.com/jmtx1020/go_quicknode/api/destinations and
+// github.com/jmtx1020/go_quicknode/api/notifications.
+package quickalerts
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/jmtx1020/go_quicknode/api/destinations"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const destinationsURL = "https://api.quicknode.com/quickalerts/rest/v1/destinations"
+
+type DestinationAPI struct {
+	API *client.APIWrapper
+}
+
+// UpdateDestinationByID updates the destination in place, keeping its ID and token.
+func (d *DestinationAPI) UpdateDestinationByID(id string, payload destinations.DestinationPayload) (*destinations.Destination, error) {
+	endpoint := fmt.Sprintf("%s/%s", destinationsURL, id)
+
+	var destination destinations.Destination
+	if err := api.Do(d.API, http.MethodPatch, endpoint, payload, &destination); err != nil {
+		return nil, err
+	}
+	return &destination, nil
+}
+
+// GetDestinationByID returns the destination, failing with an *api.Error that
+// carries the status code when it cannot be read.
+func (d *DestinationAPI) GetDestinationByID(id string) (*destinations.Destination, error) {
+	endpoint := fmt.Sprintf("%s/%s", destinationsURL, id)
+
+	var destination destinations.Destination
+	if err := api.Do(d.API, http.MethodGet, endpoint, nil, &destination); err != nil {
+		return nil, err
+	}
+	return &destination, nil
+}
+
+// DeleteDestinationByID deletes the destination.
+func (d *DestinationAPI) DeleteDestinationByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", destinationsURL, id)
+	return api.Do(d.API, http.MethodDelete, endpoint, nil, nil)
+}
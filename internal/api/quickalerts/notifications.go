@@ -0,0 +1,35 @@
+package quickalerts
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/jmtx1020/go_quicknode/api/notifications"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const notificationsURL = "https://api.quicknode.com/quickalerts/rest/v1/notifications"
+
+type NotificationAPI struct {
+	API *client.APIWrapper
+}
+
+// GetNotificationByID returns the notification, failing with an *api.Error
+// that carries the status code when it cannot be read.
+func (n *NotificationAPI) GetNotificationByID(id string) (*notifications.Notification, error) {
+	endpoint := fmt.Sprintf("%s/%s", notificationsURL, id)
+
+	var notification notifications.Notification
+	if err := api.Do(n.API, http.MethodGet, endpoint, nil, &notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// DeleteNotificationByID deletes the notification.
+func (n *NotificationAPI) DeleteNotificationByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", notificationsURL, id)
+	return api.Do(n.API, http.MethodDelete, endpoint, nil, nil)
+}
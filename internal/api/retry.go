@@ -0,0 +1,134 @@
+package api
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryTransport retries requests that failed with a 429 or a 5xx status code
+// using exponential backoff with jitter, and limits the rate requests are sent
+// at with a token bucket. It wraps the transport of a *client.APIWrapper so
+// that both the go_quicknode clients and the clients of this module use it.
+type RetryTransport struct {
+	Next http.RoundTripper
+
+	// MaxRetries is the number of times a request is retried, 0 disables retries.
+	MaxRetries int
+	// MaxWait caps the time waited between two attempts, including waits
+	// requested by a Retry-After header.
+	MaxWait time.Duration
+	// Limiter limits the rate of requests, including retries. Nil is unlimited.
+	Limiter *rate.Limiter
+}
+
+// minWait is the wait before the first retry, doubled for every further retry.
+const minWait = time.Second
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if t.Limiter != nil {
+			if err := t.Limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.Next.RoundTrip(req)
+		if attempt >= t.MaxRetries || !retryable(req, resp, err) {
+			return resp, err
+		}
+
+		// the body of a retried request is sent again
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			// drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether a request can be sent again. Rate limited
+// requests and requests the API was unavailable for were not processed, so
+// they are always retried. Other server and network errors are only retried
+// for idempotent methods, so that objects are never created twice.
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return req.Context().Err() == nil && idempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent(req.Method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the wait before retrying attempt. It is the Retry-After
+// header of resp when there is one, and otherwise an exponential backoff with
+// equal jitter.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.MaxWait)
+		}
+	}
+
+	wait := t.MaxWait
+	if attempt < 16 {
+		wait = min(minWait<<attempt, t.MaxWait)
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// retryAfter parses a Retry-After header, which holds either a number of
+// seconds or an HTTP date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
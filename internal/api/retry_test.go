@@ -0,0 +1,153 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// testServer answers with the given status codes in order, then with 200.
+func testServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32, *[]string) {
+	t.Helper()
+	var calls atomic.Int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		call := int(calls.Add(1)) - 1
+		if call < len(statuses) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(statuses[call])
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls, &bodies
+}
+
+func testClient(maxRetries int) *http.Client {
+	return &http.Client{Transport: &RetryTransport{
+		Next:       http.DefaultTransport,
+		MaxRetries: maxRetries,
+		MaxWait:    10 * time.Millisecond,
+	}}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statuses   []int
+		maxRetries int
+		calls      int32
+		status     int
+	}{
+		{"success", http.MethodGet, nil, 3, 1, http.StatusOK},
+		{"rate limited", http.MethodPost, []int{429, 429}, 3, 3, http.StatusOK},
+		{"unavailable", http.MethodPost, []int{503}, 3, 2, http.StatusOK},
+		{"server error on get", http.MethodGet, []int{500, 502}, 3, 3, http.StatusOK},
+		{"server error on post", http.MethodPost, []int{500}, 3, 1, http.StatusInternalServerError},
+		{"client error", http.MethodGet, []int{404}, 3, 1, http.StatusNotFound},
+		{"retries exhausted", http.MethodGet, []int{429, 429, 429}, 2, 3, http.StatusTooManyRequests},
+		{"retries disabled", http.MethodGet, []int{429}, 0, 1, http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls, bodies := testServer(t, tt.statuses...)
+
+			req, err := http.NewRequest(tt.method, server.URL, bytes.NewBufferString(`{"name":"test"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := testClient(tt.maxRetries).Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+			if calls.Load() != tt.calls {
+				t.Errorf("calls = %d, want %d", calls.Load(), tt.calls)
+			}
+			for _, body := range *bodies {
+				if body != `{"name":"test"}` {
+					t.Errorf("body = %q, want the request body on every attempt", body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportLimiter(t *testing.T) {
+	server, calls, _ := testServer(t)
+	client := &http.Client{Transport: &RetryTransport{
+		Next:    http.DefaultTransport,
+		Limiter: rate.NewLimiter(rate.Limit(20), 1),
+	}}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+	// the first request uses the burst, the two others wait 50ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("3 requests at 20 per second took %s", elapsed)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	transport := &RetryTransport{MaxWait: 30 * time.Second}
+
+	for attempt, maxWait := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second} {
+		wait := transport.backoff(attempt, nil)
+		if wait < maxWait/2 || wait > maxWait {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", attempt, wait, maxWait/2, maxWait)
+		}
+	}
+	if wait := transport.backoff(40, nil); wait < 15*time.Second || wait > 30*time.Second {
+		t.Errorf("backoff(40) = %s, want capped to 30s", wait)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if wait := transport.backoff(0, resp); wait != 7*time.Second {
+		t.Errorf("backoff with Retry-After 7 = %s", wait)
+	}
+	resp.Header.Set("Retry-After", "120")
+	if wait := transport.backoff(0, resp); wait != 30*time.Second {
+		t.Errorf("backoff with Retry-After 120 = %s, want capped to 30s", wait)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if wait, ok := retryAfter("3"); !ok || wait != 3*time.Second {
+		t.Errorf("retryAfter(3) = %s, %t", wait, ok)
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := retryAfter(date); !ok || wait <= 55*time.Second || wait > time.Minute {
+		t.Errorf("retryAfter(%s) = %s, %t", date, wait, ok)
+	}
+	for _, header := range []string{"", "soon", "-1"} {
+		if _, ok := retryAfter(header); ok {
+			t.Errorf("retryAfter(%q) should not parse", header)
+		}
+	}
+}
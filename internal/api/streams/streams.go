@@ -0,0 +1,98 @@
+// Package streams is a client for the QuickNode Streams REST API.
+package streams
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+)
+
+const streamsURL = "https://api.quicknode.com/streams/rest/v1/streams"
+
+type Stream struct {
+	ID                    string         `json:"id"`
+	Name                  string         `json:"name"`
+	Network               string         `json:"network"`
+	Dataset               string         `json:"dataset"`
+	FilterFunction        string         `json:"filter_function"`
+	Region                string         `json:"region"`
+	StartRange            int64          `json:"start_range"`
+	EndRange              int64          `json:"end_range"`
+	DatasetBatchSize      int64          `json:"dataset_batch_size"`
+	IncludeStreamMetadata string         `json:"include_stream_metadata"`
+	Destination           string         `json:"destination"`
+	DestinationAttributes map[string]any `json:"destination_attributes"`
+	Status                string         `json:"status"`
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+}
+
+// StreamPayload is used to create and update streams. FilterFunction must be
+// base64 encoded.
+type StreamPayload struct {
+	Name                  string         `json:"name"`
+	Network               string         `json:"network,omitempty"`
+	Dataset               string         `json:"dataset,omitempty"`
+	FilterFunction        string         `json:"filter_function,omitempty"`
+	Region                string         `json:"region,omitempty"`
+	StartRange            int64          `json:"start_range"`
+	EndRange              int64          `json:"end_range"`
+	DatasetBatchSize      int64          `json:"dataset_batch_size,omitempty"`
+	IncludeStreamMetadata string         `json:"include_stream_metadata,omitempty"`
+	Destination           string         `json:"destination"`
+	DestinationAttributes map[string]any `json:"destination_attributes"`
+	Status                string         `json:"status,omitempty"`
+}
+
+type StreamAPI struct {
+	API *client.APIWrapper
+}
+
+func (s *StreamAPI) CreateStream(payload StreamPayload) (*Stream, error) {
+	var stream Stream
+	if err := api.Do(s.API, http.MethodPost, streamsURL, payload, &stream); err != nil {
+		return nil, err
+	}
+	return &stream, nil
+}
+
+func (s *StreamAPI) GetStreamByID(id string) (*Stream, error) {
+	endpoint := fmt.Sprintf("%s/%s", streamsURL, id)
+
+	var stream Stream
+	if err := api.Do(s.API, http.MethodGet, endpoint, nil, &stream); err != nil {
+		return nil, err
+	}
+	return &stream, nil
+}
+
+func (s *StreamAPI) UpdateStreamByID(id string, payload StreamPayload) (*Stream, error) {
+	endpoint := fmt.Sprintf("%s/%s", streamsURL, id)
+
+	var stream Stream
+	if err := api.Do(s.API, http.MethodPatch, endpoint, payload, &stream); err != nil {
+		return nil, err
+	}
+	return &stream, nil
+}
+
+func (s *StreamAPI) DeleteStreamByID(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", streamsURL, id)
+
+	return api.Do(s.API, http.MethodDelete, endpoint, nil, nil)
+}
+
+// ToggleStreamByID activates the stream when activate is true and pauses it otherwise.
+func (s *StreamAPI) ToggleStreamByID(id string, activate bool) error {
+	toggle := "pause"
+	if activate {
+		toggle = "activate"
+	}
+	endpoint := fmt.Sprintf("%s/%s/%s", streamsURL, id, toggle)
+
+	return api.Do(s.API, http.MethodPatch, endpoint, nil, nil)
+}
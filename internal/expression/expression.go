@@ -0,0 +1,253 @@
+// Package expression parses and validates QuickAlerts expressions such as
+// tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045' && tx_value > 0
+// so mistakes are reported at plan time instead of by the API.
+package expression
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SyntaxError describes an invalid expression.
+type SyntaxError struct {
+	// Column is the 1-based column of the offending input, counting line
+	// breaks as a single column.
+	Column  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column, e.Message)
+}
+
+// Node is a node of a parsed expression.
+type Node interface {
+	// String returns the node in QuickAlerts expression syntax.
+	String() string
+}
+
+// Logical joins its operands with "&&" or "||".
+type Logical struct {
+	Operator string
+	Operands []Node
+}
+
+func (l *Logical) String() string {
+	operands := make([]string, len(l.Operands))
+	for i, operand := range l.Operands {
+		operands[i] = operand.String()
+		if _, ok := operand.(*Logical); ok {
+			operands[i] = "(" + operands[i] + ")"
+		}
+	}
+	return strings.Join(operands, " "+l.Operator+" ")
+}
+
+// And joins nodes with "&&". A single node is returned as is.
+func And(nodes ...Node) Node {
+	return join("&&", nodes)
+}
+
+// Or joins nodes with "||". A single node is returned as is.
+func Or(nodes ...Node) Node {
+	return join("||", nodes)
+}
+
+// join joins nodes with operator, flattening operands that use the same operator.
+func join(operator string, nodes []Node) Node {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+
+	logical := &Logical{Operator: operator}
+	for _, node := range nodes {
+		if l, ok := node.(*Logical); ok && l.Operator == operator {
+			logical.Operands = append(logical.Operands, l.Operands...)
+			continue
+		}
+		logical.Operands = append(logical.Operands, node)
+	}
+	return logical
+}
+
+// Comparison compares a field to a value.
+type Comparison struct {
+	Field    string
+	Operator string
+	Value    Value
+}
+
+func (c *Comparison) String() string {
+	return fmt.Sprintf("%s %s %s", c.Field, c.Operator, c.Value)
+}
+
+// Value is a quoted string or a number literal.
+type Value struct {
+	Text   string
+	Number bool
+}
+
+func (v Value) String() string {
+	if v.Number {
+		return v.Text
+	}
+	return "'" + v.Text + "'"
+}
+
+// Compare returns a comparison of field to value. Values of numeric fields
+// that are plain decimal numbers are not quoted.
+func Compare(field, operator, value string) Node {
+	number := fields[field] == numberField && value != ""
+	for i := 0; i < len(value); i++ {
+		number = number && isDigit(value[i])
+	}
+	return &Comparison{Field: field, Operator: operator, Value: Value{Text: value, Number: number}}
+}
+
+// Parse parses a QuickAlerts expression. The returned error is a *SyntaxError
+// when the expression is invalid.
+func Parse(input string) (Node, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 1 {
+		return nil, &SyntaxError{Column: 1, Message: "expression is empty"}
+	}
+
+	p := &parser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		if next.kind == tokenRParen {
+			return nil, &SyntaxError{Column: next.column, Message: "unmatched ')'"}
+		}
+		return nil, &SyntaxError{Column: next.column, Message: fmt.Sprintf("expected '&&' or '||', got %s", next)}
+	}
+	return node, nil
+}
+
+// Validate reports whether input is a valid QuickAlerts expression.
+func Validate(input string) error {
+	_, err := Parse(input)
+	return err
+}
+
+// Caret returns the line of input holding column followed by a line marking the column.
+func Caret(input string, column int) string {
+	lineStart := 0
+	for i := 0; i < column-1 && i < len(input); i++ {
+		if input[i] == '\n' {
+			lineStart = i + 1
+		}
+	}
+	line := input[lineStart:]
+	if end := strings.IndexByte(line, '\n'); end >= 0 {
+		line = line[:end]
+	}
+	return line + "\n" + strings.Repeat(" ", max(column-1-lineStart, 0)) + "^"
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// parseOr parses and (|| and)*
+func (p *parser) parseOr() (Node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = Or(left, right)
+	}
+	return left, nil
+}
+
+// parseAnd parses primary (&& primary)*
+func (p *parser) parseAnd() (Node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		left = And(left, right)
+	}
+	return left, nil
+}
+
+// parsePrimary parses a parenthesized expression or a comparison.
+func (p *parser) parsePrimary() (Node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenLParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, &SyntaxError{Column: closing.column, Message: fmt.Sprintf("expected ')' to close '(' at column %d, got %s", t.column, closing)}
+		}
+		return node, nil
+	case tokenIdent:
+		return p.parseComparison(t)
+	default:
+		return nil, &SyntaxError{Column: t.column, Message: fmt.Sprintf("expected a field or '(', got %s", t)}
+	}
+}
+
+// parseComparison parses the operator and value following field.
+func (p *parser) parseComparison(field token) (Node, error) {
+	kind, ok := fields[field.text]
+	if !ok {
+		return nil, &SyntaxError{Column: field.column, Message: fmt.Sprintf("unknown field %q", field.text)}
+	}
+
+	operator := p.next()
+	if operator.kind != tokenOperator {
+		return nil, &SyntaxError{Column: operator.column, Message: fmt.Sprintf("expected a comparison operator after %s, got %s", field.text, operator)}
+	}
+	if kind == stringField && operator.text != "==" && operator.text != "!=" {
+		return nil, &SyntaxError{Column: operator.column, Message: fmt.Sprintf("%s can only be compared with '==' or '!='", field.text)}
+	}
+
+	value := p.next()
+	switch value.kind {
+	case tokenString:
+		return &Comparison{Field: field.text, Operator: operator.text, Value: Value{Text: value.text}}, nil
+	case tokenNumber:
+		if kind == stringField {
+			return nil, &SyntaxError{Column: value.column, Message: fmt.Sprintf("value of %s must be quoted, e.g. '%s'", field.text, value.text)}
+		}
+		return &Comparison{Field: field.text, Operator: operator.text, Value: Value{Text: value.text, Number: true}}, nil
+	case tokenIdent:
+		return nil, &SyntaxError{Column: value.column, Message: fmt.Sprintf("expected a value, got %s; string values must be quoted", value)}
+	default:
+		return nil, &SyntaxError{Column: value.column, Message: fmt.Sprintf("expected a value, got %s", value)}
+	}
+}
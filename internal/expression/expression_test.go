@@ -0,0 +1,108 @@
+package expression
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'", "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'"},
+		{`tx_from != "0x1"`, "tx_from != '0x1'"},
+		{"tx_value > 1000", "tx_value > 1000"},
+		{"tx_value >= '1000'", "tx_value >= '1000'"},
+		{"tx_to == '0x1' && tx_value > 0", "tx_to == '0x1' && tx_value > 0"},
+		{"tx_to == '0x1' || tx_from == '0x1' && tx_value < 5", "tx_to == '0x1' || (tx_from == '0x1' && tx_value < 5)"},
+		{"(tx_to == '0x1' || tx_from == '0x1') && tx_value <= 5", "(tx_to == '0x1' || tx_from == '0x1') && tx_value <= 5"},
+		{"tx_logs_topic0 == '0xddf252ad' && (tx_logs_address == '0x2')", "tx_logs_topic0 == '0xddf252ad' && tx_logs_address == '0x2'"},
+		{"tx_to == '0x1' && (tx_value > 1 && tx_value < 5) && tx_from == '0x2'", "tx_to == '0x1' && tx_value > 1 && tx_value < 5 && tx_from == '0x2'"},
+		{"\n  tx_to == '0x1'\n", "tx_to == '0x1'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			node, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.input, err)
+			}
+			if node.String() != tt.expected {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, node, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		column int
+	}{
+		{"", 1},
+		{"   ", 1},
+		{"tx_to == 0xabc", 10},
+		{"tx_to = '0x1'", 7},
+		{"tx_too == '0x1'", 1},
+		{"tx_to == '0x1' & tx_value > 0", 16},
+		{"tx_to == '0x1' | tx_value > 0", 16},
+		{"tx_to == '0x1", 10},
+		{"tx_to == '0x1' &&", 18},
+		{"tx_to == '0x1' tx_from == '0x1'", 16},
+		{"(tx_to == '0x1'", 16},
+		{"tx_to == '0x1')", 15},
+		{"tx_to > '0x1'", 7},
+		{"tx_to == 12", 10},
+		{"tx_to == abc", 10},
+		{"tx_to '0x1'", 7},
+		{"tx_value > 10abc", 14},
+		{"== '0x1'", 1},
+		{"tx_to == '0x1' && $", 19},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			err := Validate(tt.input)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Validate(%q) = %v, want a *SyntaxError", tt.input, err)
+			}
+			if syntaxErr.Column != tt.column {
+				t.Errorf("Validate(%q) reported column %d (%s), want column %d", tt.input, syntaxErr.Column, syntaxErr.Message, tt.column)
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	node := And(
+		Compare("tx_logs_topic0", "==", "0xddf252ad"),
+		Or(
+			Compare("tx_logs_topic2", "==", "0x1"),
+			Compare("tx_logs_topic2", "==", "0x2"),
+		),
+		Compare("tx_value", ">", "1000"),
+		Compare("tx_value", "<", "0x10"),
+	)
+
+	expected := "tx_logs_topic0 == '0xddf252ad' && (tx_logs_topic2 == '0x1' || tx_logs_topic2 == '0x2') && tx_value > 1000 && tx_value < '0x10'"
+	if node.String() != expected {
+		t.Errorf("String() = %s, want %s", node, expected)
+	}
+	if err := Validate(node.String()); err != nil {
+		t.Errorf("built expression is invalid: %v", err)
+	}
+}
+
+func TestCaret(t *testing.T) {
+	expected := "tx_to == 0xabc\n         ^"
+	if caret := Caret("tx_to == 0xabc", 10); caret != expected {
+		t.Errorf("Caret() = %q, want %q", caret, expected)
+	}
+
+	expected = "  && tx_too == '0x1'\n     ^"
+	if caret := Caret("tx_to == '0x1'\n  && tx_too == '0x1'", 21); caret != expected {
+		t.Errorf("Caret() = %q, want %q", caret, expected)
+	}
+}
@@ -0,0 +1,57 @@
+package expression
+
+// fieldKind is the type of the values a field holds.
+type fieldKind int
+
+const (
+	stringField fieldKind = iota
+	numberField
+)
+
+// fields are the transaction, receipt and block fields QuickAlerts
+// expressions can reference.
+var fields = map[string]fieldKind{
+	"tx_hash":                   stringField,
+	"tx_from":                   stringField,
+	"tx_to":                     stringField,
+	"tx_input":                  stringField,
+	"tx_blockHash":              stringField,
+	"tx_r":                      stringField,
+	"tx_s":                      stringField,
+	"tx_v":                      stringField,
+	"tx_value":                  numberField,
+	"tx_nonce":                  numberField,
+	"tx_gas":                    numberField,
+	"tx_gasPrice":               numberField,
+	"tx_maxFeePerGas":           numberField,
+	"tx_maxPriorityFeePerGas":   numberField,
+	"tx_blockNumber":            numberField,
+	"tx_transactionIndex":       numberField,
+	"tx_type":                   numberField,
+	"tx_chainId":                numberField,
+	"tx_logs_address":           stringField,
+	"tx_logs_data":              stringField,
+	"tx_logs_topic0":            stringField,
+	"tx_logs_topic1":            stringField,
+	"tx_logs_topic2":            stringField,
+	"tx_logs_topic3":            stringField,
+	"receipt_contractAddress":   stringField,
+	"receipt_logsBloom":         stringField,
+	"receipt_status":            numberField,
+	"receipt_gasUsed":           numberField,
+	"receipt_cumulativeGasUsed": numberField,
+	"receipt_effectiveGasPrice": numberField,
+	"block_hash":                stringField,
+	"block_miner":               stringField,
+	"block_number":              numberField,
+	"block_timestamp":           numberField,
+	"block_gasUsed":             numberField,
+	"block_gasLimit":            numberField,
+	"block_baseFeePerGas":       numberField,
+}
+
+// IsField reports whether name is a field expressions can reference.
+func IsField(name string) bool {
+	_, ok := fields[name]
+	return ok
+}
@@ -0,0 +1,136 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// column is the 1-based column the token starts at.
+	column int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return fmt.Sprintf("string '%s'", t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// lex splits input into tokens, ending with a tokenEOF.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case isLetter(c):
+			for i < len(input) && (isLetter(input[i]) || isDigit(input[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: input[start:i], column: start + 1})
+			continue
+		case isDigit(c):
+			if strings.HasPrefix(input[i:], "0x") || strings.HasPrefix(input[i:], "0X") {
+				i += 2
+				for i < len(input) && isHexDigit(input[i]) {
+					i++
+				}
+				return nil, &SyntaxError{
+					Column:  start + 1,
+					Message: fmt.Sprintf("hex value %s must be quoted, e.g. '%s'", input[start:i], input[start:i]),
+				}
+			}
+			for i < len(input) && isDigit(input[i]) {
+				i++
+			}
+			if i < len(input) && isLetter(input[i]) {
+				return nil, &SyntaxError{Column: i + 1, Message: fmt.Sprintf("unexpected %q in number", input[i])}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: input[start:i], column: start + 1})
+			continue
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(input[i+1:], c)
+			if end < 0 {
+				return nil, &SyntaxError{Column: start + 1, Message: "unterminated string"}
+			}
+			i += end + 2
+			tokens = append(tokens, token{kind: tokenString, text: input[start+1 : i-1], column: start + 1})
+			continue
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", column: start + 1})
+			i++
+			continue
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", column: start + 1})
+			i++
+			continue
+		}
+
+		two := input[i:min(i+2, len(input))]
+		switch two {
+		case "&&":
+			tokens = append(tokens, token{kind: tokenAnd, text: two, column: start + 1})
+			i += 2
+			continue
+		case "||":
+			tokens = append(tokens, token{kind: tokenOr, text: two, column: start + 1})
+			i += 2
+			continue
+		case "==", "!=", ">=", "<=":
+			tokens = append(tokens, token{kind: tokenOperator, text: two, column: start + 1})
+			i += 2
+			continue
+		}
+
+		switch c {
+		case '>', '<':
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), column: start + 1})
+			i++
+			continue
+		case '=':
+			return nil, &SyntaxError{Column: start + 1, Message: "unexpected '=', did you mean '=='?"}
+		case '&':
+			return nil, &SyntaxError{Column: start + 1, Message: "unexpected '&', did you mean '&&'?"}
+		case '|':
+			return nil, &SyntaxError{Column: start + 1, Message: "unexpected '|', did you mean '||'?"}
+		}
+		return nil, &SyntaxError{Column: start + 1, Message: fmt.Sprintf("unexpected character %q", c)}
+	}
+	return append(tokens, token{kind: tokenEOF, column: len(input) + 1}), nil
+}
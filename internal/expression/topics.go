@@ -0,0 +1,26 @@
+package expression
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// EventTopic returns the topic0 of an event signature such as
+// "Transfer(address,address,uint256)", the hex encoded keccak256 of the
+// signature without whitespace.
+func EventTopic(signature string) string {
+	signature = strings.Join(strings.Fields(signature), "")
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(signature))
+	return "0x" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// AddressTopic returns an address left padded to 32 bytes, as it appears in
+// the indexed topics of an event.
+func AddressTopic(address string) string {
+	address = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X"))
+	return "0x" + strings.Repeat("0", 64-len(address)) + address
+}
@@ -0,0 +1,26 @@
+package expression
+
+import "testing"
+
+func TestEventTopic(t *testing.T) {
+	tests := map[string]string{
+		"Transfer(address,address,uint256)":                     "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+		"Transfer(address, address, uint256)":                   "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+		"Approval(address,address,uint256)":                     "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925",
+		"ApprovalForAll(address,address,bool)":                  "0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31",
+		"Swap(address,uint256,uint256,uint256,uint256,address)": "0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822",
+	}
+
+	for signature, expected := range tests {
+		if topic := EventTopic(signature); topic != expected {
+			t.Errorf("EventTopic(%q) = %s, want %s", signature, topic, expected)
+		}
+	}
+}
+
+func TestAddressTopic(t *testing.T) {
+	expected := "0x000000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045"
+	if topic := AddressTopic("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"); topic != expected {
+		t.Errorf("AddressTopic() = %s, want %s", topic, expected)
+	}
+}
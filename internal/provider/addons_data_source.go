@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ datasource.DataSource              = &addonsDataSource{}
+	_ datasource.DataSourceWithConfigure = &addonsDataSource{}
+)
+
+func NewAddonsDataSource() datasource.DataSource {
+	return &addonsDataSource{}
+}
+
+type addonsDataSource struct {
+	client *client.APIWrapper
+}
+
+type addonsDataSourceModel struct {
+	Chain  types.String `tfsdk:"chain"`
+	Addons []addonModel `tfsdk:"addons"`
+}
+
+type addonModel struct {
+	Slug        types.String   `tfsdk:"slug"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Chains      []types.String `tfsdk:"chains"`
+}
+
+func (a *addonsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addons"
+}
+
+func (a *addonsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"chain": schema.StringAttribute{
+				Description: "Only return add-ons available for this chain, e.g. \"eth\".",
+				Optional:    true,
+			},
+			"addons": schema.ListNestedAttribute{
+				Description: "The available marketplace add-ons.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slug": schema.StringAttribute{
+							Description: "The add-on slug, as used by quicknode_endpoint_addon.slug.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The human-readable name of the add-on.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the add-on.",
+							Computed:    true,
+						},
+						"chains": schema.ListAttribute{
+							Description: "The chains the add-on is available for.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *addonsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state addonsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, a.client)}
+	addons, err := endpointAPI.GetAllAddons(state.Chain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Add-ons",
+			err.Error())
+		return
+	}
+
+	for _, addon := range addons {
+		chains := make([]types.String, len(addon.Chains))
+		available := state.Chain.IsNull()
+		for i, chain := range addon.Chains {
+			chains[i] = types.StringValue(chain)
+			if chain == state.Chain.ValueString() {
+				available = true
+			}
+		}
+		if !available {
+			continue
+		}
+
+		state.Addons = append(state.Addons, addonModel{
+			Slug:        types.StringValue(addon.Slug),
+			Name:        types.StringValue(addon.Name),
+			Description: types.StringValue(addon.Description),
+			Chains:      chains,
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (a *addonsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = data.client
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAddonsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "quicknode_addons" "test" {
+					chain = "eth"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.quicknode_addons.test", "addons.0.slug"),
+					resource.TestCheckResourceAttrSet("data.quicknode_addons.test", "addons.0.name"),
+					resource.TestCheckTypeSetElemAttr("data.quicknode_addons.test", "addons.0.chains.*", "eth"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-quicknode/internal/expression"
+)
+
+var (
+	_ datasource.DataSource = &alertExpressionDataSource{}
+)
+
+// transferSignature is the Transfer event shared by ERC20 and ERC721 tokens.
+const transferSignature = "Transfer(address,address,uint256)"
+
+var (
+	addressRegexp        = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+	eventSignatureRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\([A-Za-z0-9_\[\], ]*\)$`)
+)
+
+func NewAlertExpressionDataSource() datasource.DataSource {
+	return &alertExpressionDataSource{}
+}
+
+// alertExpressionDataSource builds expressions locally and never calls the API.
+type alertExpressionDataSource struct{}
+
+type alertExpressionDataSourceModel struct {
+	Template       types.String   `tfsdk:"template"`
+	Addresses      []types.String `tfsdk:"addresses"`
+	Direction      types.String   `tfsdk:"direction"`
+	Contract       types.String   `tfsdk:"contract"`
+	EventSignature types.String   `tfsdk:"event_signature"`
+	Topic0         types.String   `tfsdk:"topic0"`
+	Expression     types.String   `tfsdk:"expression"`
+	ExpressionRaw  types.String   `tfsdk:"expression_raw"`
+}
+
+func (a *alertExpressionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_expression"
+}
+
+func (a *alertExpressionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	addressValidators := []validator.String{
+		stringvalidator.RegexMatches(addressRegexp, "must be a 0x prefixed 20 byte hex address"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Builds a QuickAlerts expression from a common template, ready to be used as quicknode_notification.expression.",
+		Attributes: map[string]schema.Attribute{
+			"template": schema.StringAttribute{
+				Description: "The template to build. ERC-20 and ERC-721 transfers share the Transfer event and are told apart by topic3, " +
+					"the token ID only ERC-721 indexes. ENUM: 'wallet_activity', 'erc20_transfer', 'erc721_transfer', 'contract_event'",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("wallet_activity", "erc20_transfer", "erc721_transfer", "contract_event"),
+				},
+			},
+			"addresses": schema.ListAttribute{
+				Description: "The wallet addresses to match. Required by wallet_activity, optional for the transfer templates.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(addressValidators...),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Description: "Whether the addresses send, receive or either. Defaults to 'any'. ENUM: 'from', 'to', 'any'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("from", "to", "any"),
+				},
+			},
+			"contract": schema.StringAttribute{
+				Description: "Only match logs emitted by this contract address. Not used by wallet_activity.",
+				Optional:    true,
+				Validators:  addressValidators,
+			},
+			"event_signature": schema.StringAttribute{
+				Description: "The event signature, e.g. \"Approval(address,address,uint256)\". Required by contract_event.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(eventSignatureRegexp, "must be an event signature such as \"Transfer(address,address,uint256)\""),
+				},
+			},
+			"topic0": schema.StringAttribute{
+				Description: "The keccak256 hash of the event signature, empty for wallet_activity.",
+				Computed:    true,
+			},
+			"expression": schema.StringAttribute{
+				Description: "The base64 encoded expression.",
+				Computed:    true,
+			},
+			"expression_raw": schema.StringAttribute{
+				Description: "The plain expression.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (a *alertExpressionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state alertExpressionDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node := state.build(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ExpressionRaw = types.StringValue(node.String())
+	state.Expression = types.StringValue(encodeExpression(node.String()))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// build returns the expression of the configured template and sets topic0.
+func (m *alertExpressionDataSourceModel) build(diags *diag.Diagnostics) expression.Node {
+	var nodes []expression.Node
+	direction := m.Direction.ValueString()
+	if direction == "" {
+		direction = "any"
+	}
+
+	m.Topic0 = types.StringValue("")
+	switch template := m.Template.ValueString(); template {
+	case "wallet_activity":
+		if len(m.Addresses) == 0 {
+			diags.AddAttributeError(path.Root("addresses"), "Missing Template Parameter",
+				"The wallet_activity template requires at least one address.")
+			return nil
+		}
+		nodes = append(nodes, m.matchAddresses(direction, "tx_from", "tx_to", strings.ToLower))
+	case "erc20_transfer", "erc721_transfer", "contract_event":
+		signature := transferSignature
+		if template == "contract_event" {
+			if m.EventSignature.IsNull() {
+				diags.AddAttributeError(path.Root("event_signature"), "Missing Template Parameter",
+					"The contract_event template requires an event signature.")
+				return nil
+			}
+			signature = m.EventSignature.ValueString()
+		}
+		m.Topic0 = types.StringValue(expression.EventTopic(signature))
+		nodes = append(nodes, expression.Compare("tx_logs_topic0", "==", m.Topic0.ValueString()))
+		// ERC-721 also indexes the token ID, so only its Transfer has a topic3.
+		switch template {
+		case "erc20_transfer":
+			nodes = append(nodes, expression.Compare("tx_logs_topic3", "==", ""))
+		case "erc721_transfer":
+			nodes = append(nodes, expression.Compare("tx_logs_topic3", "!=", ""))
+		}
+		if !m.Contract.IsNull() {
+			nodes = append(nodes, expression.Compare("tx_logs_address", "==", strings.ToLower(m.Contract.ValueString())))
+		}
+		// The sender and receiver are the first two indexed topics of Transfer.
+		if len(m.Addresses) > 0 && template != "contract_event" {
+			nodes = append(nodes, m.matchAddresses(direction, "tx_logs_topic1", "tx_logs_topic2", expression.AddressTopic))
+		}
+	}
+	return expression.And(nodes...)
+}
+
+// matchAddresses matches any of the addresses against the from and/or to
+// field, formatting each address with format.
+func (m *alertExpressionDataSourceModel) matchAddresses(direction, from, to string, format func(string) string) expression.Node {
+	var nodes []expression.Node
+	for _, address := range m.Addresses {
+		value := format(address.ValueString())
+		if direction != "to" {
+			nodes = append(nodes, expression.Compare(from, "==", value))
+		}
+		if direction != "from" {
+			nodes = append(nodes, expression.Compare(to, "==", value))
+		}
+	}
+	return expression.Or(nodes...)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAlertExpressionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "quicknode_alert_expression" "test" {
+					template  = "erc20_transfer"
+					addresses = ["0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"]
+					direction = "to"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quicknode_alert_expression.test", "topic0", "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+					resource.TestCheckResourceAttr("data.quicknode_alert_expression.test", "expression_raw", "tx_logs_topic0 == '0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef' && tx_logs_topic3 == '' && tx_logs_topic2 == '0x000000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045'"),
+					resource.TestCheckResourceAttrSet("data.quicknode_alert_expression.test", "expression"),
+				),
+			},
+		},
+	})
+}
+
+func TestAlertExpressionBuild(t *testing.T) {
+	const (
+		wallet   = "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
+		contract = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+		transfer = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+		padded   = "0x000000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045"
+	)
+
+	tests := map[string]struct {
+		model    alertExpressionDataSourceModel
+		expected string
+		err      bool
+	}{
+		"wallet activity": {
+			model: alertExpressionDataSourceModel{
+				Template:  types.StringValue("wallet_activity"),
+				Addresses: []types.String{types.StringValue(wallet)},
+			},
+			expected: "tx_from == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045' || tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'",
+		},
+		"wallet activity from": {
+			model: alertExpressionDataSourceModel{
+				Template:  types.StringValue("wallet_activity"),
+				Addresses: []types.String{types.StringValue(wallet)},
+				Direction: types.StringValue("from"),
+			},
+			expected: "tx_from == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'",
+		},
+		"wallet activity without addresses": {
+			model: alertExpressionDataSourceModel{
+				Template: types.StringValue("wallet_activity"),
+			},
+			err: true,
+		},
+		"erc20 transfer": {
+			model: alertExpressionDataSourceModel{
+				Template:  types.StringValue("erc20_transfer"),
+				Addresses: []types.String{types.StringValue(wallet)},
+				Contract:  types.StringValue(contract),
+			},
+			expected: "tx_logs_topic0 == '" + transfer + "' && tx_logs_topic3 == '' && tx_logs_address == '0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48' && " +
+				"(tx_logs_topic1 == '" + padded + "' || tx_logs_topic2 == '" + padded + "')",
+		},
+		"erc721 transfer": {
+			model: alertExpressionDataSourceModel{
+				Template: types.StringValue("erc721_transfer"),
+			},
+			expected: "tx_logs_topic0 == '" + transfer + "' && tx_logs_topic3 != ''",
+		},
+		"contract event": {
+			model: alertExpressionDataSourceModel{
+				Template:       types.StringValue("contract_event"),
+				EventSignature: types.StringValue("Approval(address, address, uint256)"),
+				Contract:       types.StringValue(contract),
+			},
+			expected: "tx_logs_topic0 == '0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925' && tx_logs_address == '0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48'",
+		},
+		"contract event without signature": {
+			model: alertExpressionDataSourceModel{
+				Template: types.StringValue("contract_event"),
+			},
+			err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			node := test.model.build(&diags)
+			if diags.HasError() != test.err {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if test.err {
+				return
+			}
+			if node.String() != test.expected {
+				t.Errorf("got %s, want %s", node, test.expected)
+			}
+		})
+	}
+}
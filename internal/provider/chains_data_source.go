@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ datasource.DataSource              = &chainsDataSource{}
+	_ datasource.DataSourceWithConfigure = &chainsDataSource{}
+)
+
+func NewChainsDataSource() datasource.DataSource {
+	return &chainsDataSource{}
+}
+
+type chainsDataSource struct {
+	client *client.APIWrapper
+}
+
+type chainsDataSourceModel struct {
+	Chain  types.String `tfsdk:"chain"`
+	Chains []chainModel `tfsdk:"chains"`
+}
+
+type chainModel struct {
+	Chain              types.String `tfsdk:"chain"`
+	Network            types.String `tfsdk:"network"`
+	Name               types.String `tfsdk:"name"`
+	QuickAlertsNetwork types.String `tfsdk:"quickalerts_network"`
+}
+
+// quickAlertsChainNames maps the chain slugs of the Admin API to the chain
+// names QuickAlerts uses, where they differ.
+var quickAlertsChainNames = map[string]string{
+	"arb":   "arbitrum",
+	"avax":  "avalanche",
+	"bsc":   "bnbchain",
+	"eth":   "ethereum",
+	"matic": "polygon",
+	"xdai":  "gnosis",
+}
+
+// quickAlertsNetwork returns the QuickAlerts network of a chain and network
+// pair, e.g. "ethereum-mainnet" for eth and mainnet.
+func quickAlertsNetwork(chain, network string) string {
+	if name, ok := quickAlertsChainNames[chain]; ok {
+		chain = name
+	}
+	return chain + "-" + network
+}
+
+func (c *chainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chains"
+}
+
+func (c *chainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"chain": schema.StringAttribute{
+				Description: "Only return the networks of this chain, e.g. \"eth\".",
+				Optional:    true,
+			},
+			"chains": schema.ListNestedAttribute{
+				Description: "The supported chain and network pairs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"chain": schema.StringAttribute{
+							Description: "The chain slug, as used by quicknode_endpoint.chain.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "The network slug, as used by quicknode_endpoint.network.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The human-readable name of the network.",
+							Computed:    true,
+						},
+						"quickalerts_network": schema.StringAttribute{
+							Description: "The QuickAlerts identifier of the network, e.g. \"ethereum-mainnet\", as used by quicknode_notification.network.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *chainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state chainsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, c.client)}
+	chains, err := endpointAPI.GetAllChains()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Chains",
+			err.Error())
+		return
+	}
+
+	for _, chain := range chains {
+		if !state.Chain.IsNull() && chain.Slug != state.Chain.ValueString() {
+			continue
+		}
+
+		for _, network := range chain.Networks {
+			state.Chains = append(state.Chains, chainModel{
+				Chain:              types.StringValue(chain.Slug),
+				Network:            types.StringValue(network.Slug),
+				Name:               types.StringValue(network.Name),
+				QuickAlertsNetwork: types.StringValue(quickAlertsNetwork(chain.Slug, network.Slug)),
+			})
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (c *chainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	c.client = data.client
+}
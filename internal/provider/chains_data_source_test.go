@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestChainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				data "quicknode_chains" "test" {
+					chain = "eth"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quicknode_chains.test", "chains.0.chain", "eth"),
+					resource.TestCheckResourceAttrSet("data.quicknode_chains.test", "chains.0.network"),
+					resource.TestCheckResourceAttrSet("data.quicknode_chains.test", "chains.0.name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.quicknode_chains.test", "chains.*", map[string]string{
+						"chain":               "eth",
+						"network":             "mainnet",
+						"quickalerts_network": "ethereum-mainnet",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestQuickAlertsNetwork(t *testing.T) {
+	tests := []struct {
+		chain, network, want string
+	}{
+		{"eth", "mainnet", "ethereum-mainnet"},
+		{"eth", "sepolia", "ethereum-sepolia"},
+		{"matic", "amoy", "polygon-amoy"},
+		{"base", "mainnet", "base-mainnet"},
+	}
+
+	for _, tt := range tests {
+		if got := quickAlertsNetwork(tt.chain, tt.network); got != tt.want {
+			t.Errorf("quickAlertsNetwork(%q, %q) = %q, want %q", tt.chain, tt.network, got, tt.want)
+		}
+	}
+}
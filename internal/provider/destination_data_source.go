@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	destinations "github.com/jmtx1020/go_quicknode/api/destinations"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
 )
 
 var (
@@ -60,11 +62,18 @@ func (d *destinationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: "The type of payload to send. ENUM: 1,2,3,4,5,6,7",
 				Computed:    true,
 			},
+			"labels": schema.MapAttribute{
+				Description: "The labels encoded at the end of the name of the destination.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"created_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was created.",
 				Computed:    true,
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was last updated.",
 				Computed:    true,
 			},
@@ -73,10 +82,10 @@ func (d *destinationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (d *destinationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state destinationResourceModel
+	var state destinationModel
 	req.Config.GetAttribute(ctx, path.Root("id"), &state.ID)
 
-	destinationAPI := &destinations.DestinationAPI{API: d.client}
+	destinationAPI := &destinations.DestinationAPI{API: api.WithContext(ctx, d.client)}
 	dest, err := destinationAPI.GetDestinationByID(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -85,14 +94,16 @@ func (d *destinationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	state.ID = types.StringValue(dest.ID)
-	state.Name = types.StringValue(dest.Name)
+	name, labels := decodeLabels(dest.Name)
+	state.Name = types.StringValue(name)
+	state.Labels = labels
 	state.To = types.StringValue(dest.To)
 	state.Token = types.StringValue(dest.Token)
 	state.WebhookType = types.StringValue(dest.WebhookType)
 	state.Service = types.StringValue(dest.Service)
 	state.PayloadType = types.Int64Value(int64(dest.PayloadType))
-	state.CreatedAt = types.StringValue(dest.CreatedAt.Format("2006-01-02 15:04:05"))
-	state.UpdatedAt = types.StringValue(dest.UpdatedAt.Format("2006-01-02 15:04:05"))
+	state.CreatedAt = newTimestampValue(dest.CreatedAt)
+	state.UpdatedAt = newTimestampValue(dest.UpdatedAt)
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
@@ -107,14 +118,14 @@ func (d *destinationDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiWrapper
+	d.client = data.client
 }
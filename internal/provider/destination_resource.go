@@ -4,23 +4,35 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	destinations "github.com/jmtx1020/go_quicknode/api/destinations"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/quickalerts"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &destinationResource{}
-	_ resource.ResourceWithConfigure   = &destinationResource{}
-	_ resource.ResourceWithImportState = &destinationResource{}
+	_ resource.Resource                 = &destinationResource{}
+	_ resource.ResourceWithConfigure    = &destinationResource{}
+	_ resource.ResourceWithImportState  = &destinationResource{}
+	_ resource.ResourceWithModifyPlan   = &destinationResource{}
+	_ resource.ResourceWithUpgradeState = &destinationResource{}
 )
 
 type destinationResource struct {
-	client *client.APIWrapper
+	client        *client.APIWrapper
+	defaultLabels map[string]string
 }
 
 func NewDestinationResource() resource.Resource {
@@ -28,15 +40,18 @@ func NewDestinationResource() resource.Resource {
 }
 
 type destinationResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	To          types.String `tfsdk:"to"`
-	WebhookType types.String `tfsdk:"webhook_type"`
-	Service     types.String `tfsdk:"service"`
-	Token       types.String `tfsdk:"token"`
-	PayloadType types.Int64  `tfsdk:"payload_type"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	To          types.String   `tfsdk:"to"`
+	WebhookType types.String   `tfsdk:"webhook_type"`
+	Service     types.String   `tfsdk:"service"`
+	Token       types.String   `tfsdk:"token"`
+	PayloadType types.Int64    `tfsdk:"payload_type"`
+	CreatedAt   timestampValue `tfsdk:"created_at"`
+	UpdatedAt   timestampValue `tfsdk:"updated_at"`
+	Labels      types.Map      `tfsdk:"labels"`
+	LabelsAll   types.Map      `tfsdk:"labels_all"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Configure adds the provider configured client to the resource.
@@ -45,16 +60,17 @@ func (r *destinationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = apiWrapper
+	r.client = data.client
+	r.defaultLabels = data.defaultLabels
 }
 
 // Metadata returns the resource type name.
@@ -63,46 +79,78 @@ func (r *destinationResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *destinationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *destinationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "ID given by API for the destination.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Description: "User supplied name given to the destination.",
 				Required:    true,
 			},
+			"labels":     labelsAttribute("name"),
+			"labels_all": labelsAllAttribute(),
 			"to": schema.StringAttribute{
 				Description: "The webhook URL to which QuickAlerts will send alert payloads.",
 				Required:    true,
+				Validators: []validator.String{
+					urlValidator{},
+				},
 			},
 			"webhook_type": schema.StringAttribute{
 				Description: "The type of destination. ENUM: 'POST', 'GET'",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("POST", "GET"),
+				},
 			},
 			"service": schema.StringAttribute{
-				Description: "The destination service. Currently only \"webhook\" is supported.",
+				Description: "The destination service. Currently only \"webhook\" is supported. Changing this forces a new destination to be created.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("webhook"),
+				},
 			},
 			"token": schema.StringAttribute{
 				Description: "The token for this destination. This is used to optionally verify a QuickAlerts payload.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"payload_type": schema.Int64Attribute{
 				Description: "The type of payload to send. ENUM: 1,2,3,4,5,6,7",
 				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 7),
+				},
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was created.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was last updated.",
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -116,9 +164,24 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	destinationsAPI := &destinations.DestinationAPI{API: r.client}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	name, diags := encodeLabelsValue(ctx, plan.Name.ValueString(), plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destinationsAPI := &destinations.DestinationAPI{API: api.WithContext(ctx, r.client)}
 	dest, err := destinationsAPI.CreateDestination(
-		plan.Name.ValueString(),
+		name,
 		plan.To.ValueString(),
 		plan.WebhookType.ValueString(),
 		plan.Service.ValueString(),
@@ -135,8 +198,8 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 
 	plan.ID = types.StringValue(dest.ID)
 	plan.Token = types.StringValue(dest.Token)
-	plan.CreatedAt = types.StringValue(dest.CreatedAt.Format("2006-01-02 15:04:05"))
-	plan.UpdatedAt = types.StringValue(dest.UpdatedAt.Format("2006-01-02 15:04:05"))
+	plan.CreatedAt = newTimestampValue(dest.CreatedAt)
+	plan.UpdatedAt = newTimestampValue(dest.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -145,6 +208,11 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 }
 
+// ModifyPlan merges the default labels.
+func (r *destinationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanLabels(ctx, r.defaultLabels, req, resp)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
@@ -155,10 +223,23 @@ func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	destinationsAPI := &destinations.DestinationAPI{API: r.client}
-	// Get refreshed order value from HashiCups
-	dest, err := destinationsAPI.GetDestinationByID(fmt.Sprint(state.ID.ValueString()))
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	destinationsAPI := &quickalerts.DestinationAPI{API: api.WithContext(ctx, r.client)}
+	dest, err := destinationsAPI.GetDestinationByID(state.ID.ValueString())
 	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading QuickNode Destination",
 			"Could not read QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
@@ -168,13 +249,15 @@ func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	state.ID = types.StringValue(dest.ID)
 	state.Token = types.StringValue(dest.Token)
-	state.Name = types.StringValue(dest.Name)
+	name, labels := decodeLabels(dest.Name)
+	state.Name = types.StringValue(name)
+	readLabels(r.defaultLabels, labels, &state.Labels, &state.LabelsAll)
 	state.To = types.StringValue(dest.To)
 	state.WebhookType = types.StringValue(dest.WebhookType)
 	state.Service = types.StringValue(dest.Service)
 	state.PayloadType = types.Int64Value(int64(dest.PayloadType))
-	state.CreatedAt = types.StringValue(dest.CreatedAt.Format("2006-01-02 15:04:05"))
-	state.UpdatedAt = types.StringValue(dest.UpdatedAt.Format("2006-01-02 15:04:05"))
+	state.CreatedAt = newTimestampValue(dest.CreatedAt)
+	state.UpdatedAt = newTimestampValue(dest.UpdatedAt)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -194,6 +277,15 @@ func (r *destinationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var state destinationResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -201,36 +293,33 @@ func (r *destinationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	destinationsAPI := &destinations.DestinationAPI{API: r.client}
-
-	err := destinationsAPI.DeleteDestinationByID(state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Update - Error Deleting QuickNode Destination.",
-			"Update - Could not delete QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
-		)
+	name, diags := encodeLabelsValue(ctx, plan.Name.ValueString(), plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	dest, err := destinationsAPI.CreateDestination(
-		plan.Name.ValueString(),
-		plan.To.ValueString(),
-		plan.WebhookType.ValueString(),
-		plan.Service.ValueString(),
-		int(plan.PayloadType.ValueInt64()),
-	)
+	// the destination is updated in place so its ID and token stay valid
+	destinationsAPI := &quickalerts.DestinationAPI{API: api.WithContext(ctx, r.client)}
+	dest, err := destinationsAPI.UpdateDestinationByID(state.ID.ValueString(), destinations.DestinationPayload{
+		Name:        name,
+		ToURL:       plan.To.ValueString(),
+		WebhookType: plan.WebhookType.ValueString(),
+		Service:     plan.Service.ValueString(),
+		PayloadType: int(plan.PayloadType.ValueInt64()),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Update - Error Creating QuickNode Destination.",
-			"Update - Could not creating QuickNode Name "+state.Name.ValueString()+": "+err.Error(),
+			"Error Updating QuickNode Destination.",
+			"Could not update QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
 		)
 		return
 	}
 
-	plan.ID = types.StringValue(dest.ID)
-	plan.Token = types.StringValue(dest.Token)
-	plan.CreatedAt = types.StringValue(dest.CreatedAt.Format("2006-01-02 15:04:05"))
-	plan.UpdatedAt = types.StringValue(dest.UpdatedAt.Format("2006-01-02 15:04:05"))
+	plan.ID = state.ID
+	plan.Token = state.Token
+	plan.CreatedAt = state.CreatedAt
+	plan.UpdatedAt = newTimestampValue(dest.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -249,11 +338,20 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	destinationsAPI := &destinations.DestinationAPI{API: r.client}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	destinationsAPI := &quickalerts.DestinationAPI{API: api.WithContext(ctx, r.client)}
 
-	// Delete existing order
+	// A destination that is already gone counts as deleted
 	err := destinationsAPI.DeleteDestinationByID(state.ID.ValueString())
-	if err != nil {
+	if err != nil && !api.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting QuickNode Destination"+state.ID.ValueString(),
 			"Could not delete destination, unexpected error: "+err.Error(),
@@ -262,6 +360,11 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// UpgradeState converts the timestamps of version 0 state to RFC3339.
+func (r *destinationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return timestampStateUpgraders("created_at", "updated_at")
+}
+
 func (r *destinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
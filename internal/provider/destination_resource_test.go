@@ -1,12 +1,18 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestDestinationResource(t *testing.T) {
+	var id, token string
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -31,7 +37,12 @@ func TestDestinationResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("quicknode_destination.test", "id"),
 					resource.TestCheckResourceAttrSet("quicknode_destination.test", "token"),
 					resource.TestCheckResourceAttrSet("quicknode_destination.test", "updated_at"),
-					resource.TestCheckResourceAttrSet("quicknode_destination.test", "created_at"),
+					resource.TestMatchResourceAttr("quicknode_destination.test", "created_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(Z|[+-]\d{2}:\d{2})$`)),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["quicknode_destination.test"].Primary.Attributes
+						id, token = attributes["id"], attributes["token"]
+						return nil
+					},
 				),
 			},
 			// ImportState testing
@@ -54,9 +65,51 @@ func TestDestinationResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify first destination has attributes updated.
 					resource.TestCheckResourceAttr("quicknode_destination.test", "name", "ds-tf-testing-update"),
+					// Verify the destination was updated in place.
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["quicknode_destination.test"].Primary.Attributes
+						if attributes["id"] != id || attributes["token"] != token {
+							return fmt.Errorf("destination was replaced: id %s -> %s", id, attributes["id"])
+						}
+						return nil
+					},
+				),
+			},
+			// Labels testing
+			{
+				Config: `
+					provider "quicknode" {
+						default_labels = {
+							team        = "platform"
+							environment = "test"
+						}
+					}
+
+					resource "quicknode_destination" "test" {
+						name         = "ds-tf-testing-update"
+						to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+						webhook_type = "POST"
+						service      = "webhook"
+						payload_type = 1
+						labels       = {
+							team = "payments"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_destination.test", "name", "ds-tf-testing-update"),
+					resource.TestCheckResourceAttr("quicknode_destination.test", "labels_all.%", "2"),
+					resource.TestCheckResourceAttr("quicknode_destination.test", "labels_all.team", "payments"),
+					resource.TestCheckResourceAttr("quicknode_destination.test", "labels_all.environment", "test"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestDestinationResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewDestinationResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "dest-1"),
+	})
+}
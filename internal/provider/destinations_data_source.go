@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	destinations "github.com/jmtx1020/go_quicknode/api/destinations"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
 )
 
 var (
@@ -25,19 +27,21 @@ type destinationsDataSource struct {
 }
 
 type destinationsDataSourceModel struct {
+	Labels       map[string]string  `tfsdk:"labels"`
 	Destinations []destinationModel `tfsdk:"destinations"`
 }
 
 type destinationModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	To          types.String `tfsdk:"to"`
-	WebhookType types.String `tfsdk:"webhook_type"`
-	Service     types.String `tfsdk:"service"`
-	Token       types.String `tfsdk:"token"`
-	PayloadType types.Int64  `tfsdk:"payload_type"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	To          types.String      `tfsdk:"to"`
+	WebhookType types.String      `tfsdk:"webhook_type"`
+	Service     types.String      `tfsdk:"service"`
+	Token       types.String      `tfsdk:"token"`
+	PayloadType types.Int64       `tfsdk:"payload_type"`
+	Labels      map[string]string `tfsdk:"labels"`
+	CreatedAt   timestampValue    `tfsdk:"created_at"`
+	UpdatedAt   timestampValue    `tfsdk:"updated_at"`
 }
 
 func (d *destinationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +51,11 @@ func (d *destinationsDataSource) Metadata(_ context.Context, req datasource.Meta
 func (d *destinationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"labels": schema.MapAttribute{
+				Description: "Only return the destinations that have all of these labels.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"destinations": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -79,11 +88,18 @@ func (d *destinationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							Description: "The type of payload to send. ENUM: 1,2,3,4,5,6,7",
 							Computed:    true,
 						},
+						"labels": schema.MapAttribute{
+							Description: "The labels encoded at the end of the name of the destination.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 						"created_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was created.",
 							Computed:    true,
 						},
 						"updated_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was last updated.",
 							Computed:    true,
 						},
@@ -96,8 +112,13 @@ func (d *destinationsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 
 func (d *destinationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state destinationsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	destinationAPI := &destinations.DestinationAPI{API: d.client}
+	destinationAPI := &destinations.DestinationAPI{API: api.WithContext(ctx, d.client)}
 
 	dests, err := destinationAPI.GetAllDestinations()
 	if err != nil {
@@ -107,23 +128,29 @@ func (d *destinationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	for _, dest := range dests {
+		name, labels := decodeLabels(dest.Name)
+		if !matchLabels(labels, state.Labels) {
+			continue
+		}
+
 		destState := destinationModel{
 			ID:          types.StringValue(dest.ID),
-			Name:        types.StringValue(dest.Name),
+			Name:        types.StringValue(name),
+			Labels:      labels,
 			To:          types.StringValue(dest.To),
 			WebhookType: types.StringValue(dest.WebhookType),
 			Service:     types.StringValue(dest.Service),
 			Token:       types.StringValue(dest.Token),
 			PayloadType: types.Int64Value(int64(dest.PayloadType)),
-			CreatedAt:   types.StringValue(dest.CreatedAt.Format("2006-01-02 15:04:05")),
-			UpdatedAt:   types.StringValue(dest.UpdatedAt.Format("2006-01-02 15:04:05")),
+			CreatedAt:   newTimestampValue(dest.CreatedAt),
+			UpdatedAt:   newTimestampValue(dest.UpdatedAt),
 		}
 
 		state.Destinations = append(state.Destinations, destState)
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -135,14 +162,14 @@ func (d *destinationsDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = apiWrapper
+	d.client = data.client
 }
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ resource.Resource                = &endpointAddonResource{}
+	_ resource.ResourceWithConfigure   = &endpointAddonResource{}
+	_ resource.ResourceWithImportState = &endpointAddonResource{}
+)
+
+type endpointAddonResource struct {
+	client *client.APIWrapper
+}
+
+func NewEndpointAddonResource() resource.Resource {
+	return &endpointAddonResource{}
+}
+
+type endpointAddonResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	EndpointID types.String   `tfsdk:"endpoint_id"`
+	Slug       types.String   `tfsdk:"slug"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *endpointAddonResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = data.client
+}
+
+// Metadata returns the resource type name.
+func (e *endpointAddonResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_addon"
+}
+
+func (e *endpointAddonResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a marketplace add-on to an endpoint. Removing the resource detaches the add-on.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The slug of the add-on.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint the add-on is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Description: "The slug of the add-on, as listed by the quicknode_addons data source. Changing this forces a new add-on to be attached.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (e *endpointAddonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan endpointAddonResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.AttachAddon(
+		plan.EndpointID.ValueString(),
+		plan.Slug.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error attaching endpoint add-on",
+			"Could not attach endpoint add-on, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Slug
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointAddonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state endpointAddonResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	addons, err := endpointAPI.GetEndpointAddons(state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint Add-on",
+			"Could not read add-ons of QuickNode Endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, addon := range addons {
+		if addon.Slug == state.ID.ValueString() {
+			state.Slug = types.StringValue(addon.Slug)
+
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	tflog.Warn(ctx, "Endpoint add-on not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only refreshes the state as every configurable attribute forces a replacement.
+func (e *endpointAddonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan endpointAddonResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *endpointAddonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state endpointAddonResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.DetachAddon(state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint Add-on "+state.ID.ValueString(),
+			"Could not detach endpoint add-on, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *endpointAddonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import IDs are of the form <endpoint_id>/<slug>
+	importStateEndpointItem(ctx, req, resp)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEndpointAddonResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_addon" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+					slug        = "trace"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint_addon.test", "id", "trace"),
+					resource.TestCheckResourceAttrPair("quicknode_endpoint_addon.test", "endpoint_id", "quicknode_endpoint.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_addon.test", "slug", "trace"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_endpoint_addon.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEndpointItemImportID("quicknode_endpoint_addon.test"),
+			},
+		},
+	})
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ resource.Resource                = &endpointAllowlistItemResource{}
+	_ resource.ResourceWithConfigure   = &endpointAllowlistItemResource{}
+	_ resource.ResourceWithImportState = &endpointAllowlistItemResource{}
+)
+
+// endpointAllowlistItem is an item of one of the allowlists in the security
+// settings of an endpoint, such as a referrer or an IP.
+type endpointAllowlistItem struct {
+	ID    string
+	Value string
+}
+
+// endpointAllowlistItemResource manages a single item of an endpoint
+// allowlist. The allowlists only differ in their names and API calls, so each
+// resource type only wires those up.
+type endpointAllowlistItemResource struct {
+	client *client.APIWrapper
+
+	// typeName is the resource type name without the provider prefix.
+	typeName string
+	// attribute is the name of the attribute holding the item.
+	attribute string
+	// name and title name the item in lower and title case in messages.
+	name  string
+	title string
+
+	endpointIDDescription string
+	attributeDescription  string
+
+	create func(e *endpoints.EndpointAPI, endpointID, value string) (string, error)
+	list   func(security *endpoints.Security) []endpointAllowlistItem
+	delete func(e *endpoints.EndpointAPI, endpointID, itemID string) error
+}
+
+type endpointAllowlistItemResourceModel struct {
+	ID         types.String
+	EndpointID types.String
+	Value      types.String
+	Timeouts   timeouts.Value
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// get reads the model from a plan or a state. The item attribute is named
+// after the resource type, so the model cannot be read with struct tags.
+func (e *endpointAllowlistItemResource) get(ctx context.Context, data attributeGetter) (endpointAllowlistItemResourceModel, diag.Diagnostics) {
+	var model endpointAllowlistItemResourceModel
+	var diags diag.Diagnostics
+	diags.Append(data.GetAttribute(ctx, path.Root("id"), &model.ID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("endpoint_id"), &model.EndpointID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root(e.attribute), &model.Value)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	return model, diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *endpointAllowlistItemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = data.client
+}
+
+// Metadata returns the resource type name.
+func (e *endpointAllowlistItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + e.typeName
+}
+
+func (e *endpointAllowlistItemResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The " + e.name + " ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: e.endpointIDDescription,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			e.attribute: schema.StringAttribute{
+				Description: e.attributeDescription + " Changing this forces a new " + e.name + " to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (e *endpointAllowlistItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, diags := e.get(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	id, err := e.create(endpointAPI, plan.EndpointID.ValueString(), plan.Value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint "+e.name,
+			"Could not create endpoint "+e.name+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (e *endpointAllowlistItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, diags := e.get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	security, err := endpointAPI.GetSecurityByEndpointID(state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint "+e.title,
+			"Could not read security of QuickNode Endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, item := range e.list(security) {
+		if item.ID == state.ID.ValueString() {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(e.attribute), item.Value)...)
+			return
+		}
+	}
+
+	tflog.Warn(ctx, "Endpoint "+e.name+" not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only refreshes the state as every configurable attribute forces a replacement.
+func (e *endpointAllowlistItemResource) Update(_ context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (e *endpointAllowlistItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state, diags := e.get(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := e.delete(endpointAPI, state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint "+e.title+" "+state.ID.ValueString(),
+			"Could not delete endpoint "+e.name+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *endpointAllowlistItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import IDs are of the form <endpoint_id>/<item_id>
+	importStateEndpointItem(ctx, req, resp)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jmtx1020/go_quicknode/client"
+)
+
+// securityTransport answers every request with the security settings of an
+// endpoint that has one item in each allowlist.
+type securityTransport struct{}
+
+func (securityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := `{"data":{
+		"referrers":[{"id":"1","referrer":"app.example.com"}],
+		"ips":[{"id":"1","ip":"203.0.113.7"}],
+		"domain_masks":[{"id":"1","domain_mask":"rpc.example.com"}]
+	}}`
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func TestEndpointAllowlistItemResourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		newResource func() fwresource.Resource
+		attribute   string
+		value       string
+	}{
+		{NewEndpointReferrerResource, "referrer", "app.example.com"},
+		{NewEndpointIPResource, "ip", "203.0.113.7"},
+		{NewEndpointDomainMaskResource, "domain_mask", "rpc.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute, func(t *testing.T) {
+			r := tt.newResource().(*endpointAllowlistItemResource)
+			r.client = &client.APIWrapper{Client: &http.Client{Transport: securityTransport{}}}
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			emptyState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+			for importID, found := range map[string]bool{"endpoint/1": true, "endpoint/2": false} {
+				importResp := fwresource.ImportStateResponse{State: emptyState}
+				r.ImportState(ctx, fwresource.ImportStateRequest{ID: importID}, &importResp)
+				if importResp.Diagnostics.HasError() {
+					t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+				}
+
+				readResp := fwresource.ReadResponse{State: importResp.State}
+				r.Read(ctx, fwresource.ReadRequest{State: importResp.State}, &readResp)
+				if readResp.Diagnostics.HasError() {
+					t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+				}
+
+				if !found {
+					if !readResp.State.Raw.IsNull() {
+						t.Errorf("%s should be removed from the state", importID)
+					}
+					continue
+				}
+
+				var value types.String
+				readResp.State.GetAttribute(ctx, path.Root(tt.attribute), &value)
+				if value.ValueString() != tt.value {
+					t.Errorf("%s = %q, want %q", tt.attribute, value.ValueString(), tt.value)
+				}
+			}
+		})
+	}
+}
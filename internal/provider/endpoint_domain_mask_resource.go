@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+func NewEndpointDomainMaskResource() resource.Resource {
+	return &endpointAllowlistItemResource{
+		typeName:              "endpoint_domain_mask",
+		attribute:             "domain_mask",
+		name:                  "domain mask",
+		title:                 "Domain Mask",
+		endpointIDDescription: "The ID of the endpoint the domain mask points at.",
+		attributeDescription:  "The custom domain that masks the endpoint URL.",
+		create: func(e *endpoints.EndpointAPI, endpointID, domainMask string) (string, error) {
+			item, err := e.CreateDomainMask(endpointID, domainMask)
+			if err != nil {
+				return "", err
+			}
+			return item.ID, nil
+		},
+		list: func(security *endpoints.Security) []endpointAllowlistItem {
+			items := make([]endpointAllowlistItem, 0, len(security.DomainMasks))
+			for _, item := range security.DomainMasks {
+				items = append(items, endpointAllowlistItem{ID: item.ID, Value: item.DomainMask})
+			}
+			return items
+		},
+		delete: (*endpoints.EndpointAPI).DeleteDomainMask,
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEndpointDomainMaskResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_domain_mask" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+					domain_mask = "rpc.example.com"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_domain_mask.test", "id"),
+					resource.TestCheckResourceAttrPair("quicknode_endpoint_domain_mask.test", "endpoint_id", "quicknode_endpoint.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_domain_mask.test", "domain_mask", "rpc.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_endpoint_domain_mask.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEndpointItemImportID("quicknode_endpoint_domain_mask.test"),
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+func NewEndpointIPResource() resource.Resource {
+	return &endpointAllowlistItemResource{
+		typeName:              "endpoint_ip",
+		attribute:             "ip",
+		name:                  "IP",
+		title:                 "IP",
+		endpointIDDescription: "The ID of the endpoint the IP is allowed on.",
+		attributeDescription:  "The IP address allowed to use the endpoint.",
+		create: func(e *endpoints.EndpointAPI, endpointID, ip string) (string, error) {
+			item, err := e.CreateIP(endpointID, ip)
+			if err != nil {
+				return "", err
+			}
+			return item.ID, nil
+		},
+		list: func(security *endpoints.Security) []endpointAllowlistItem {
+			items := make([]endpointAllowlistItem, 0, len(security.IPs))
+			for _, item := range security.IPs {
+				items = append(items, endpointAllowlistItem{ID: item.ID, Value: item.IP})
+			}
+			return items
+		},
+		delete: (*endpoints.EndpointAPI).DeleteIP,
+	}
+}
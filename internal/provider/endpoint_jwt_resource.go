@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ resource.Resource                = &endpointJWTResource{}
+	_ resource.ResourceWithConfigure   = &endpointJWTResource{}
+	_ resource.ResourceWithImportState = &endpointJWTResource{}
+)
+
+type endpointJWTResource struct {
+	client *client.APIWrapper
+}
+
+func NewEndpointJWTResource() resource.Resource {
+	return &endpointJWTResource{}
+}
+
+type endpointJWTResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	EndpointID types.String   `tfsdk:"endpoint_id"`
+	PublicKey  types.String   `tfsdk:"public_key"`
+	KID        types.String   `tfsdk:"kid"`
+	Name       types.String   `tfsdk:"name"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *endpointJWTResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = data.client
+}
+
+// Metadata returns the resource type name.
+func (e *endpointJWTResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_jwt"
+}
+
+func (e *endpointJWTResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The JWT ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint that requires the JWT.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The PEM encoded public key used to verify the signature of the JWT. Changing this forces a new JWT to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					pemPublicKeyValidator{},
+				},
+			},
+			"kid": schema.StringAttribute{
+				Description: "The key ID that identifies the public key in the JWT header. Changing this forces a new JWT to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the JWT. Changing this forces a new JWT to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (e *endpointJWTResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan endpointJWTResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	jwt, err := endpointAPI.CreateJWT(
+		plan.EndpointID.ValueString(),
+		plan.PublicKey.ValueString(),
+		plan.KID.ValueString(),
+		plan.Name.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint JWT",
+			"Could not create endpoint JWT, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(jwt.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointJWTResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state endpointJWTResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	security, err := endpointAPI.GetSecurityByEndpointID(state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint JWT",
+			"Could not read security of QuickNode Endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, jwt := range security.JWTs {
+		if jwt.ID == state.ID.ValueString() {
+			state.KID = types.StringValue(jwt.KID)
+			state.Name = types.StringValue(jwt.Name)
+
+			// the API may reformat the key, only take it over when it is a different key
+			if !samePEMPublicKey(state.PublicKey.ValueString(), jwt.PublicKey) {
+				state.PublicKey = types.StringValue(jwt.PublicKey)
+			}
+
+			diags = resp.State.Set(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	tflog.Warn(ctx, "Endpoint JWT not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only refreshes the state as every configurable attribute forces a replacement.
+func (e *endpointJWTResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan endpointJWTResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *endpointJWTResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state endpointJWTResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.DeleteJWT(state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint JWT "+state.ID.ValueString(),
+			"Could not delete endpoint JWT, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *endpointJWTResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import IDs are of the form <endpoint_id>/<jwt_id>
+	importStateEndpointItem(ctx, req, resp)
+}
+
+// parsePEMPublicKey decodes the first PEM block of s and parses it as a PKIX public key.
+func parsePEMPublicKey(s string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("expected a PEM block of type \"PUBLIC KEY\", got %q", block.Type)
+	}
+	if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+		return nil, err
+	}
+	return block.Bytes, nil
+}
+
+// samePEMPublicKey reports whether a and b encode the same public key,
+// ignoring differences in whitespace and line wrapping.
+func samePEMPublicKey(a, b string) bool {
+	aKey, err := parsePEMPublicKey(a)
+	if err != nil {
+		return a == b
+	}
+	bKey, err := parsePEMPublicKey(b)
+	if err != nil {
+		return a == b
+	}
+	return string(aKey) == string(bKey)
+}
+
+var _ validator.String = pemPublicKeyValidator{}
+
+// pemPublicKeyValidator checks that a string is a PEM encoded public key.
+type pemPublicKeyValidator struct{}
+
+func (v pemPublicKeyValidator) Description(_ context.Context) string {
+	return "value must be a PEM encoded public key"
+}
+
+func (v pemPublicKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pemPublicKeyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePEMPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Public Key",
+			"The public key must be a PEM encoded PKIX public key (\"-----BEGIN PUBLIC KEY-----\"): "+err.Error(),
+		)
+	}
+}
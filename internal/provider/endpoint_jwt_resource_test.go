@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEndpointJWTResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan time validation of the public key
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint_jwt" "test" {
+					endpoint_id = "not-created"
+					name        = "tf-testing-jwt"
+					kid         = "tf-testing-jwt"
+					public_key  = "not a pem"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Invalid PEM Public Key"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_jwt" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+					name        = "tf-testing-jwt"
+					kid         = "tf-testing-jwt"
+					public_key  = <<-EOT
+						-----BEGIN PUBLIC KEY-----
+						MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEnlU9y0rpXUhBH25jATLEOx1c5KPi
+						qoUBtgoUAnjZFOEg+vuI0QkemIrIFZ3GrLPPSi/oUNJdqnpewXxH3ZeUQA==
+						-----END PUBLIC KEY-----
+					EOT
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_jwt.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_jwt.test", "name", "tf-testing-jwt"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_jwt.test", "kid", "tf-testing-jwt"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_endpoint_jwt.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEndpointItemImportID("quicknode_endpoint_jwt.test"),
+			},
+		},
+	})
+}
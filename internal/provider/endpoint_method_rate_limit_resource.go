@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ resource.Resource                = &endpointMethodRateLimitResource{}
+	_ resource.ResourceWithConfigure   = &endpointMethodRateLimitResource{}
+	_ resource.ResourceWithImportState = &endpointMethodRateLimitResource{}
+)
+
+type endpointMethodRateLimitResource struct {
+	client *client.APIWrapper
+}
+
+func NewEndpointMethodRateLimitResource() resource.Resource {
+	return &endpointMethodRateLimitResource{}
+}
+
+type endpointMethodRateLimitResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	EndpointID types.String   `tfsdk:"endpoint_id"`
+	Interval   types.String   `tfsdk:"interval"`
+	Methods    []types.String `tfsdk:"methods"`
+	Rate       types.Int64    `tfsdk:"rate"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *endpointMethodRateLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = data.client
+}
+
+// Metadata returns the resource type name.
+func (e *endpointMethodRateLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_method_rate_limit"
+}
+
+func (e *endpointMethodRateLimitResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The method rate limit ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint the method rate limit applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interval": schema.StringAttribute{
+				Description: "The interval the rate is counted over. ENUM: 'second', 'minute', 'day'. Changing this forces a new method rate limit to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("second", "minute", "day"),
+				},
+			},
+			"methods": schema.SetAttribute{
+				Description: "The RPC methods the rate limit applies to, e.g. \"eth_getLogs\". The order is not significant.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"rate": schema.Int64Attribute{
+				Description: "The maximum number of requests to the methods per interval.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (e *endpointMethodRateLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan endpointMethodRateLimitResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Convert []types.String to []string
+	methods := make([]string, len(plan.Methods))
+	for i, method := range plan.Methods {
+		methods[i] = method.ValueString()
+	}
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	methodRateLimit, err := endpointAPI.CreateMethodRateLimit(
+		plan.EndpointID.ValueString(),
+		plan.Interval.ValueString(),
+		methods,
+		plan.Rate.ValueInt64(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint method rate limit",
+			"Could not create endpoint method rate limit, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(methodRateLimit.ID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointMethodRateLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state endpointMethodRateLimitResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	methodRateLimits, err := endpointAPI.GetMethodRateLimits(state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint Method Rate Limit",
+			"Could not read method rate limits of QuickNode Endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	for _, methodRateLimit := range methodRateLimits {
+		if methodRateLimit.ID != state.ID.ValueString() {
+			continue
+		}
+
+		methods := make([]types.String, len(methodRateLimit.Methods))
+		for i, method := range methodRateLimit.Methods {
+			methods[i] = types.StringValue(method)
+		}
+
+		state.Interval = types.StringValue(methodRateLimit.Interval)
+		state.Methods = methods
+		state.Rate = types.Int64Value(methodRateLimit.Rate)
+
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Warn(ctx, "Endpoint method rate limit not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+func (e *endpointMethodRateLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan endpointMethodRateLimitResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state endpointMethodRateLimitResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	methods := make([]string, len(plan.Methods))
+	for i, method := range plan.Methods {
+		methods[i] = method.ValueString()
+	}
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.UpdateMethodRateLimit(
+		state.EndpointID.ValueString(),
+		state.ID.ValueString(),
+		methods,
+		plan.Rate.ValueInt64(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating QuickNode Endpoint Method Rate Limit.",
+			"Could not update QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointMethodRateLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state endpointMethodRateLimitResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.DeleteMethodRateLimit(state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint Method Rate Limit "+state.ID.ValueString(),
+			"Could not delete endpoint method rate limit, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *endpointMethodRateLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import IDs are of the form <endpoint_id>/<method_rate_limit_id>
+	importStateEndpointItem(ctx, req, resp)
+}
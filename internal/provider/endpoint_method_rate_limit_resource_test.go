@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEndpointMethodRateLimitResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan time validation of the methods
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint_method_rate_limit" "test" {
+					endpoint_id = "not-created"
+					interval    = "second"
+					methods     = []
+					rate        = 5
+				}
+				`,
+				ExpectError: regexp.MustCompile("must contain at least 1 elements"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_method_rate_limit" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+					interval    = "second"
+					methods     = ["eth_getLogs"]
+					rate        = 5
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_method_rate_limit.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "interval", "second"),
+					resource.TestCheckTypeSetElemAttr("quicknode_endpoint_method_rate_limit.test", "methods.*", "eth_getLogs"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "rate", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_endpoint_method_rate_limit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEndpointItemImportID("quicknode_endpoint_method_rate_limit.test"),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_method_rate_limit" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+					interval    = "second"
+					methods     = ["eth_getLogs", "trace_block"]
+					rate        = 2
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "methods.#", "2"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "rate", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ resource.Resource                = &endpointRateLimitsResource{}
+	_ resource.ResourceWithConfigure   = &endpointRateLimitsResource{}
+	_ resource.ResourceWithImportState = &endpointRateLimitsResource{}
+)
+
+type endpointRateLimitsResource struct {
+	client *client.APIWrapper
+}
+
+func NewEndpointRateLimitsResource() resource.Resource {
+	return &endpointRateLimitsResource{}
+}
+
+type endpointRateLimitsResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	EndpointID types.String   `tfsdk:"endpoint_id"`
+	RPS        types.Int64    `tfsdk:"rps"`
+	RPM        types.Int64    `tfsdk:"rpm"`
+	RPD        types.Int64    `tfsdk:"rpd"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m endpointRateLimitsResourceModel) rateLimits() endpoints.RateLimits {
+	return endpoints.RateLimits{
+		RPS: m.RPS.ValueInt64Pointer(),
+		RPM: m.RPM.ValueInt64Pointer(),
+		RPD: m.RPD.ValueInt64Pointer(),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *endpointRateLimitsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = data.client
+}
+
+// Metadata returns the resource type name.
+func (e *endpointRateLimitsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_rate_limits"
+}
+
+func (e *endpointRateLimitsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the rate limits of an endpoint. An endpoint has a single set of rate limits, removing the resource lifts them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the endpoint the rate limits apply to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint the rate limits apply to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rps": schema.Int64Attribute{
+				Description: "The maximum number of requests per second. Unlimited when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rpm": schema.Int64Attribute{
+				Description: "The maximum number of requests per minute. Unlimited when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rpd": schema.Int64Attribute{
+				Description: "The maximum number of requests per day. Unlimited when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (e *endpointRateLimitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan endpointRateLimitsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.UpdateRateLimits(plan.EndpointID.ValueString(), plan.rateLimits())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting endpoint rate limits",
+			"Could not set endpoint rate limits, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.EndpointID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointRateLimitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state endpointRateLimitsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	rateLimits, err := endpointAPI.GetRateLimits(state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint Rate Limits",
+			"Could not read rate limits of QuickNode Endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = state.EndpointID
+	state.RPS = types.Int64PointerValue(rateLimits.RPS)
+	state.RPM = types.Int64PointerValue(rateLimits.RPM)
+	state.RPD = types.Int64PointerValue(rateLimits.RPD)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointRateLimitsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan endpointRateLimitsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.UpdateRateLimits(plan.EndpointID.ValueString(), plan.rateLimits())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating QuickNode Endpoint Rate Limits.",
+			"Could not update rate limits of QuickNode Endpoint ID "+plan.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.EndpointID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointRateLimitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state endpointRateLimitsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// rate limits can't be deleted, lift them instead
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	err := endpointAPI.UpdateRateLimits(state.EndpointID.ValueString(), endpoints.RateLimits{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint Rate Limits "+state.EndpointID.ValueString(),
+			"Could not remove endpoint rate limits, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *endpointRateLimitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The rate limits are imported by the ID of their endpoint
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), req.ID)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEndpointRateLimitsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_rate_limits" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+					rps         = 50
+					rpd         = 1000000
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("quicknode_endpoint_rate_limits.test", "id", "quicknode_endpoint.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_rate_limits.test", "rps", "50"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_rate_limits.test", "rpd", "1000000"),
+					resource.TestCheckNoResourceAttr("quicknode_endpoint_rate_limits.test", "rpm"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_endpoint_rate_limits.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_rate_limits" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+					rpm         = 600
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint_rate_limits.test", "rpm", "600"),
+					resource.TestCheckNoResourceAttr("quicknode_endpoint_rate_limits.test", "rps"),
+				),
+			},
+		},
+	})
+}
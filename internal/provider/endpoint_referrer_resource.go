@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+func NewEndpointReferrerResource() resource.Resource {
+	return &endpointAllowlistItemResource{
+		typeName:              "endpoint_referrer",
+		attribute:             "referrer",
+		name:                  "referrer",
+		title:                 "Referrer",
+		endpointIDDescription: "The ID of the endpoint the referrer is allowed on.",
+		attributeDescription:  "The referrer allowed to use the endpoint, e.g. \"app.example.com\".",
+		create: func(e *endpoints.EndpointAPI, endpointID, referrer string) (string, error) {
+			item, err := e.CreateReferrer(endpointID, referrer)
+			if err != nil {
+				return "", err
+			}
+			return item.ID, nil
+		},
+		list: func(security *endpoints.Security) []endpointAllowlistItem {
+			items := make([]endpointAllowlistItem, 0, len(security.Referrers))
+			for _, item := range security.Referrers {
+				items = append(items, endpointAllowlistItem{ID: item.ID, Value: item.Referrer})
+			}
+			return items
+		},
+		delete: (*endpoints.EndpointAPI).DeleteReferrer,
+	}
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ resource.Resource                = &endpointResource{}
+	_ resource.ResourceWithConfigure   = &endpointResource{}
+	_ resource.ResourceWithImportState = &endpointResource{}
+	_ resource.ResourceWithModifyPlan  = &endpointResource{}
+)
+
+type endpointResource struct {
+	client        *client.APIWrapper
+	defaultLabels map[string]string
+}
+
+func NewEndpointResource() resource.Resource {
+	return &endpointResource{}
+}
+
+type endpointResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Chain     types.String   `tfsdk:"chain"`
+	Network   types.String   `tfsdk:"network"`
+	Label     types.String   `tfsdk:"label"`
+	Labels    types.Map      `tfsdk:"labels"`
+	LabelsAll types.Map      `tfsdk:"labels_all"`
+	Status    types.String   `tfsdk:"status"`
+	HTTPURL   types.String   `tfsdk:"http_url"`
+	WSSURL    types.String   `tfsdk:"wss_url"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (e *endpointResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = data.client
+	e.defaultLabels = data.defaultLabels
+}
+
+// Metadata returns the resource type name.
+func (e *endpointResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint"
+}
+
+func (e *endpointResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The endpoint ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"chain": schema.StringAttribute{
+				Description: "The chain the endpoint serves, e.g. \"eth\". Changing this forces a new endpoint to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network": schema.StringAttribute{
+				Description: "The network of the chain the endpoint serves, e.g. \"mainnet\". Changing this forces a new endpoint to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "A human-readable label for the endpoint.",
+				Optional:    true,
+			},
+			"labels":     labelsAttribute("label"),
+			"labels_all": labelsAllAttribute(),
+			"status": schema.StringAttribute{
+				Description: "The status of the endpoint. ENUM: 'active', 'paused'",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("active"),
+			},
+			"http_url": schema.StringAttribute{
+				Description: "The HTTP URL of the endpoint.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"wss_url": schema.StringAttribute{
+				Description: "The WebSocket URL of the endpoint.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (e *endpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan endpointResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	endpoint, err := endpointAPI.CreateEndpoint(
+		plan.Chain.ValueString(),
+		plan.Network.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint",
+			"Could not create endpoint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the endpoint ID is known from here on, so save it before the follow-up
+	// calls to avoid orphaning the endpoint if one of them fails
+	plan.ID = types.StringValue(endpoint.ID)
+	plan.HTTPURL = types.StringValue(endpoint.HTTPURL)
+	plan.WSSURL = types.StringValue(endpoint.WSSURL)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+
+	label, diags := encodeLabelsValue(ctx, plan.Label.ValueString(), plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the label and status can't be set on creation, so apply them afterwards
+	if label != "" {
+		err = endpointAPI.UpdateEndpointByID(endpoint.ID, label)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error labeling endpoint",
+				"Could not label endpoint, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.Status.ValueString() != endpoint.Status {
+		err = endpointAPI.UpdateEndpointStatusByID(endpoint.ID, plan.Status.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating endpoint status",
+				"Could not update endpoint status, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan merges the default labels.
+func (e *endpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanLabels(ctx, e.defaultLabels, req, resp)
+}
+
+func (e *endpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state endpointResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	endpoint, err := endpointAPI.GetEndpointByID(state.ID.ValueString())
+	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint",
+			"Could not read QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(endpoint.ID)
+	state.Chain = types.StringValue(endpoint.Chain)
+	state.Network = types.StringValue(endpoint.Network)
+	state.Status = types.StringValue(endpoint.Status)
+	state.HTTPURL = types.StringValue(endpoint.HTTPURL)
+	state.WSSURL = types.StringValue(endpoint.WSSURL)
+
+	// an unlabeled endpoint comes back with an empty label, keep it null
+	label, labels := decodeLabels(endpoint.Label)
+	if label != "" || !state.Label.IsNull() {
+		state.Label = types.StringValue(label)
+	}
+	readLabels(e.defaultLabels, labels, &state.Labels, &state.LabelsAll)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan endpointResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state endpointResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+
+	if !plan.Label.Equal(state.Label) || !plan.LabelsAll.Equal(state.LabelsAll) {
+		label, diags := encodeLabelsValue(ctx, plan.Label.ValueString(), plan.LabelsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := endpointAPI.UpdateEndpointByID(state.ID.ValueString(), label)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating QuickNode Endpoint.",
+				"Could not update QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Status.Equal(state.Status) {
+		err := endpointAPI.UpdateEndpointStatusByID(state.ID.ValueString(), plan.Status.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating QuickNode Endpoint Status.",
+				"Could not update status of QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.HTTPURL = state.HTTPURL
+	plan.WSSURL = state.WSSURL
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state endpointResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	// An endpoint that is already gone counts as deleted
+	err := endpointAPI.DeleteEndpointByID(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint "+state.ID.ValueString(),
+			"Could not delete endpoint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *endpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
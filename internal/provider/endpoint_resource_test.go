@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEndpointResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+					label   = "tf-testing-endpoint"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "chain", "eth"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "network", "mainnet"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "label", "tf-testing-endpoint"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "status", "active"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint.test", "id"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint.test", "http_url"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint.test", "wss_url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_endpoint.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+					label   = "tf-testing-endpoint-update"
+					status  = "paused"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "label", "tf-testing-endpoint-update"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "status", "paused"),
+				),
+			},
+		},
+	})
+}
+
+func TestEndpointResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewEndpointResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "endpoint-1"),
+	})
+}
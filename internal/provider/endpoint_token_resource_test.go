@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestEndpointTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+				}
+				resource "quicknode_endpoint_token" "test" {
+					endpoint_id = resource.quicknode_endpoint.test.id
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_token.test", "id"),
+					resource.TestCheckResourceAttrPair("quicknode_endpoint_token.test", "endpoint_id", "quicknode_endpoint.test", "id"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_token.test", "token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_endpoint_token.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEndpointItemImportID("quicknode_endpoint_token.test"),
+			},
+		},
+	})
+}
+
+// testAccEndpointItemImportID builds the <endpoint_id>/<item_id> import ID of
+// resources that live underneath an endpoint.
+func testAccEndpointItemImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["endpoint_id"] + "/" + rs.Primary.ID, nil
+	}
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/endpoints"
+)
+
+var (
+	_ datasource.DataSource              = &endpointsDataSource{}
+	_ datasource.DataSourceWithConfigure = &endpointsDataSource{}
+)
+
+func NewEndpointsDataSource() datasource.DataSource {
+	return &endpointsDataSource{}
+}
+
+type endpointsDataSource struct {
+	client *client.APIWrapper
+}
+
+type endpointsDataSourceModel struct {
+	Chain      types.String      `tfsdk:"chain"`
+	Network    types.String      `tfsdk:"network"`
+	LabelRegex types.String      `tfsdk:"label_regex"`
+	Labels     map[string]string `tfsdk:"labels"`
+	Status     types.String      `tfsdk:"status"`
+	Endpoints  []endpointModel   `tfsdk:"endpoints"`
+}
+
+type endpointModel struct {
+	ID      types.String      `tfsdk:"id"`
+	Chain   types.String      `tfsdk:"chain"`
+	Network types.String      `tfsdk:"network"`
+	Label   types.String      `tfsdk:"label"`
+	Labels  map[string]string `tfsdk:"labels"`
+	Status  types.String      `tfsdk:"status"`
+	HTTPURL types.String      `tfsdk:"http_url"`
+	WSSURL  types.String      `tfsdk:"wss_url"`
+}
+
+func (e *endpointsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoints"
+}
+
+func (e *endpointsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"chain": schema.StringAttribute{
+				Description: "Only return endpoints serving this chain, e.g. \"eth\".",
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Only return endpoints serving this network, e.g. \"mainnet\".",
+				Optional:    true,
+			},
+			"label_regex": schema.StringAttribute{
+				Description: "Only return endpoints whose label matches this regular expression.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Only return endpoints that have all of these labels.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return endpoints with this status. ENUM: 'active', 'paused'",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "paused"),
+				},
+			},
+			"endpoints": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The endpoint ID.",
+							Computed:    true,
+						},
+						"chain": schema.StringAttribute{
+							Description: "The chain the endpoint serves.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "The network of the chain the endpoint serves.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "A human-readable label for the endpoint.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "The labels encoded at the end of the label of the endpoint.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the endpoint.",
+							Computed:    true,
+						},
+						"http_url": schema.StringAttribute{
+							Description: "The HTTP URL of the endpoint.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"wss_url": schema.StringAttribute{
+							Description: "The WebSocket URL of the endpoint.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *endpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state endpointsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labelRegex *regexp.Regexp
+	if !state.LabelRegex.IsNull() {
+		var err error
+		labelRegex, err = regexp.Compile(state.LabelRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("label_regex"),
+				"Invalid Label Regular Expression",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	endpointAPI := &endpoints.EndpointAPI{API: api.WithContext(ctx, e.client)}
+	allEndpoints, err := endpointAPI.GetAllEndpoints()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Endpoints",
+			err.Error())
+		return
+	}
+
+	for _, endpoint := range allEndpoints {
+		if !state.Chain.IsNull() && endpoint.Chain != state.Chain.ValueString() {
+			continue
+		}
+		if !state.Network.IsNull() && endpoint.Network != state.Network.ValueString() {
+			continue
+		}
+		if !state.Status.IsNull() && endpoint.Status != state.Status.ValueString() {
+			continue
+		}
+		label, labels := decodeLabels(endpoint.Label)
+		if labelRegex != nil && !labelRegex.MatchString(label) {
+			continue
+		}
+		if !matchLabels(labels, state.Labels) {
+			continue
+		}
+
+		endpointState := endpointModel{
+			ID:      types.StringValue(endpoint.ID),
+			Chain:   types.StringValue(endpoint.Chain),
+			Network: types.StringValue(endpoint.Network),
+			Label:   types.StringValue(label),
+			Labels:  labels,
+			Status:  types.StringValue(endpoint.Status),
+			HTTPURL: types.StringValue(endpoint.HTTPURL),
+			WSSURL:  types.StringValue(endpoint.WSSURL),
+		}
+
+		state.Endpoints = append(state.Endpoints, endpointState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (e *endpointsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = data.client
+}
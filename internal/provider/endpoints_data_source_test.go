@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEndpointsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_endpoint" "test" {
+					chain   = "eth"
+					network = "mainnet"
+					label   = "tf-testing-endpoints"
+				}
+				data "quicknode_endpoints" "test" {
+					chain       = resource.quicknode_endpoint.test.chain
+					label_regex = "^tf-testing-endpoints$"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quicknode_endpoints.test", "endpoints.#", "1"),
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoints.test", "endpoints.0.id"),
+					resource.TestCheckResourceAttr("data.quicknode_endpoints.test", "endpoints.0.chain", "eth"),
+					resource.TestCheckResourceAttr("data.quicknode_endpoints.test", "endpoints.0.network", "mainnet"),
+					resource.TestCheckResourceAttr("data.quicknode_endpoints.test", "endpoints.0.label", "tf-testing-endpoints"),
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoints.test", "endpoints.0.status"),
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoints.test", "endpoints.0.http_url"),
+				),
+			},
+		},
+	})
+}
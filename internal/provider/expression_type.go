@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = base64ExpressionType{}
+	_ basetypes.StringValuableWithSemanticEquals = base64ExpressionValue{}
+)
+
+// decodeExpression decodes a base64 encoded QuickAlerts expression. Padded,
+// unpadded and URL safe encodings are all accepted.
+func decodeExpression(encoded string) (string, error) {
+	encoded = strings.TrimSpace(encoded)
+	var err error
+	for _, encoding := range []*base64.Encoding{
+		base64.StdEncoding,
+		base64.RawStdEncoding,
+		base64.URLEncoding,
+		base64.RawURLEncoding,
+	} {
+		var decoded []byte
+		decoded, err = encoding.DecodeString(encoded)
+		if err == nil {
+			return string(decoded), nil
+		}
+	}
+	return "", fmt.Errorf("expression is not base64 encoded: %w", err)
+}
+
+// encodeExpression base64 encodes a QuickAlerts expression as the API expects.
+func encodeExpression(expression string) string {
+	return base64.StdEncoding.EncodeToString([]byte(expression))
+}
+
+// base64ExpressionType is a string holding a base64 encoded expression.
+type base64ExpressionType struct {
+	basetypes.StringType
+}
+
+func (t base64ExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(base64ExpressionType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t base64ExpressionType) String() string {
+	return "base64ExpressionType"
+}
+
+func (t base64ExpressionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return base64ExpressionValue{StringValue: in}, nil
+}
+
+func (t base64ExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+func (t base64ExpressionType) ValueType(_ context.Context) attr.Value {
+	return base64ExpressionValue{}
+}
+
+// base64ExpressionValue is a base64 encoded expression. Two values are
+// semantically equal when they decode to the same expression.
+type base64ExpressionValue struct {
+	basetypes.StringValue
+}
+
+func newBase64ExpressionValue(encoded string) base64ExpressionValue {
+	return base64ExpressionValue{StringValue: basetypes.NewStringValue(encoded)}
+}
+
+func (v base64ExpressionValue) Equal(o attr.Value) bool {
+	other, ok := o.(base64ExpressionValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v base64ExpressionValue) Type(_ context.Context) attr.Type {
+	return base64ExpressionType{}
+}
+
+func (v base64ExpressionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(base64ExpressionValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldExpression, err := decodeExpression(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newExpression, err := decodeExpression(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return oldExpression == newExpression, diags
+}
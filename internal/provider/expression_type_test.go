@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBase64ExpressionSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{"identical", "dHhfdG8gPT0gJzB4MSc=", "dHhfdG8gPT0gJzB4MSc=", true},
+		{"unpadded", "dHhfdG8gPT0gJzB4MSc=", "dHhfdG8gPT0gJzB4MSc", true},
+		{"surrounding whitespace", "dHhfdG8gPT0gJzB4MSc=", "dHhfdG8gPT0gJzB4MSc=\n", true},
+		{"different expression", "dHhfdG8gPT0gJzB4MSc=", "dHhfdG8gPT0gJzB4Mic=", false},
+		{"invalid encoding", "dHhfdG8gPT0gJzB4MSc=", "tx_to == '0x1'", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := newBase64ExpressionValue(tt.old).StringSemanticEquals(context.Background(), newBase64ExpressionValue(tt.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tt.old, tt.new, equal, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEncodeExpression(t *testing.T) {
+	expression := "tx_to == '0x1'"
+	encoded := encodeExpression(expression)
+	if encoded != "dHhfdG8gPT0gJzB4MSc=" {
+		t.Errorf("encodeExpression(%q) = %q", expression, encoded)
+	}
+
+	decoded, err := decodeExpression(encoded)
+	if err != nil || decoded != expression {
+		t.Errorf("decodeExpression(%q) = %q, %v, want %q", encoded, decoded, err, expression)
+	}
+}
@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/functions"
+)
+
+var (
+	_ resource.Resource                     = &functionResource{}
+	_ resource.ResourceWithConfigure        = &functionResource{}
+	_ resource.ResourceWithImportState      = &functionResource{}
+	_ resource.ResourceWithConfigValidators = &functionResource{}
+	_ resource.ResourceWithModifyPlan       = &functionResource{}
+)
+
+type functionResource struct {
+	client        *client.APIWrapper
+	defaultLabels map[string]string
+}
+
+func NewFunctionResource() resource.Resource {
+	return &functionResource{}
+}
+
+type functionResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	Runtime        types.String   `tfsdk:"runtime"`
+	SourceFile     types.String   `tfsdk:"source_file"`
+	Code           types.String   `tfsdk:"code"`
+	SourceCodeHash types.String   `tfsdk:"source_code_hash"`
+	Timeout        types.Int64    `tfsdk:"timeout"`
+	Environment    types.Map      `tfsdk:"environment"`
+	CreatedAt      timestampValue `tfsdk:"created_at"`
+	UpdatedAt      timestampValue `tfsdk:"updated_at"`
+	Labels         types.Map      `tfsdk:"labels"`
+	LabelsAll      types.Map      `tfsdk:"labels_all"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// sourceCode returns the inline code or the content of the source file.
+func (m functionResourceModel) sourceCode() (string, error) {
+	if m.SourceFile.IsNull() {
+		return m.Code.ValueString(), nil
+	}
+
+	code, err := os.ReadFile(m.SourceFile.ValueString())
+	if err != nil {
+		return "", err
+	}
+	return string(code), nil
+}
+
+// payload converts the model to the API payload, reading the source file if
+// needed and encoding the labels in the description.
+func (m functionResourceModel) payload(ctx context.Context) (functions.FunctionPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	code, err := m.sourceCode()
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_file"), "Unable to Read Function Source", err.Error())
+		return functions.FunctionPayload{}, diags
+	}
+
+	environment := map[string]string{}
+	if !m.Environment.IsNull() {
+		diags.Append(m.Environment.ElementsAs(ctx, &environment, false)...)
+	}
+
+	description, descriptionDiags := encodeLabelsValue(ctx, m.Description.ValueString(), m.LabelsAll)
+	diags.Append(descriptionDiags...)
+
+	return functions.FunctionPayload{
+		Name:        m.Name.ValueString(),
+		Description: description,
+		Kind:        m.Runtime.ValueString(),
+		Code:        code,
+		Timeout:     m.Timeout.ValueInt64(),
+		Environment: environment,
+	}, diags
+}
+
+// sourceCodeHash returns the hex encoded SHA-256 of the function code.
+func sourceCodeHash(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// Configure adds the provider configured client to the resource.
+func (f *functionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	f.client = data.client
+	f.defaultLabels = data.defaultLabels
+}
+
+// Metadata returns the resource type name.
+func (f *functionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function"
+}
+
+func (f *functionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a QuickNode Function. Exactly one of source_file or code must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The function ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the function.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the function.",
+				Optional:    true,
+			},
+			"labels":     labelsAttribute("description"),
+			"labels_all": labelsAllAttribute(),
+			"runtime": schema.StringAttribute{
+				Description: "The runtime the function is executed with, e.g. \"nodejs:20\" or \"python:3\".",
+				Required:    true,
+			},
+			"source_file": schema.StringAttribute{
+				Description: "The path of a local file holding the function code.",
+				Optional:    true,
+			},
+			"code": schema.StringAttribute{
+				Description: "The function code.",
+				Optional:    true,
+			},
+			"source_code_hash": schema.StringAttribute{
+				Description: "The SHA-256 of the function code. Edits to source_file change the hash and cause the function to be updated.",
+				Computed:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "The number of seconds the function may run for.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"environment": schema.MapAttribute{
+				Description: "The environment variables available to the function.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:  timestampType{},
+				Description: "The date and time the function was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType:  timestampType{},
+				Description: "The date and time the function was last updated.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ConfigValidators ensures the code comes from exactly one place.
+func (f *functionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("source_file"),
+			path.MatchRoot("code"),
+		),
+	}
+}
+
+// ModifyPlan hashes the function code so edits to the source file show up in
+// the plan, and merges the default labels.
+func (f *functionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to hash when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	modifyPlanLabels(ctx, f.defaultLabels, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan functionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceFile.IsUnknown() || plan.Code.IsUnknown() {
+		return
+	}
+
+	code, err := plan.sourceCode()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_file"), "Unable to Read Function Source", err.Error())
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("source_code_hash"), sourceCodeHash(code))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (f *functionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan functionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	functionAPI := &functions.FunctionAPI{API: api.WithContext(ctx, f.client)}
+	function, err := functionAPI.CreateFunction(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating function",
+			"Could not create function, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(function.ID)
+	plan.SourceCodeHash = types.StringValue(sourceCodeHash(payload.Code))
+	plan.CreatedAt = newTimestampValue(function.CreatedAt)
+	plan.UpdatedAt = newTimestampValue(function.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (f *functionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state functionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	functionAPI := &functions.FunctionAPI{API: api.WithContext(ctx, f.client)}
+	function, err := functionAPI.GetFunctionByID(state.ID.ValueString())
+	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Function",
+			"Could not read QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(function.Name)
+	state.Runtime = types.StringValue(function.Kind)
+	state.CreatedAt = newTimestampValue(function.CreatedAt)
+	state.UpdatedAt = newTimestampValue(function.UpdatedAt)
+
+	description, labels := decodeLabels(function.Description)
+	if description != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(description)
+	}
+	readLabels(f.defaultLabels, labels, &state.Labels, &state.LabelsAll)
+	if function.Timeout != 0 && !state.Timeout.IsNull() {
+		state.Timeout = types.Int64Value(function.Timeout)
+	}
+
+	// the hash of the deployed code detects edits made outside of Terraform
+	if function.Code != "" {
+		state.SourceCodeHash = types.StringValue(sourceCodeHash(function.Code))
+		if !state.Code.IsNull() {
+			state.Code = types.StringValue(function.Code)
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (f *functionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan functionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state functionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	functionAPI := &functions.FunctionAPI{API: api.WithContext(ctx, f.client)}
+	function, err := functionAPI.UpdateFunctionByID(state.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating QuickNode Function.",
+			"Could not update QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.SourceCodeHash = types.StringValue(sourceCodeHash(payload.Code))
+	plan.CreatedAt = newTimestampValue(function.CreatedAt)
+	plan.UpdatedAt = newTimestampValue(function.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (f *functionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state functionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	functionAPI := &functions.FunctionAPI{API: api.WithContext(ctx, f.client)}
+	// A function that is already gone counts as deleted
+	err := functionAPI.DeleteFunctionByID(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Function "+state.ID.ValueString(),
+			"Could not delete function, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (f *functionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
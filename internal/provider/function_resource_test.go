@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFunctionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan time validation of the code source
+			{
+				Config: providerConfig + `
+				resource "quicknode_function" "test" {
+					name    = "tf-testing-function"
+					runtime = "nodejs:20"
+				}
+				`,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_function" "test" {
+					name    = "tf-testing-function"
+					runtime = "nodejs:20"
+					code    = "function main(params) { return params; }"
+					timeout = 30
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_function.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_function.test", "name", "tf-testing-function"),
+					resource.TestCheckResourceAttr("quicknode_function.test", "runtime", "nodejs:20"),
+					resource.TestCheckResourceAttr("quicknode_function.test", "timeout", "30"),
+					resource.TestCheckResourceAttr("quicknode_function.test", "source_code_hash", sourceCodeHash("function main(params) { return params; }")),
+					resource.TestCheckResourceAttrSet("quicknode_function.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "quicknode_function.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"code", "timeout", "environment"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_function" "test" {
+					name        = "tf-testing-function"
+					description = "updated"
+					runtime     = "nodejs:20"
+					code        = "function main(params) { return { params }; }"
+					timeout     = 30
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_function.test", "description", "updated"),
+					resource.TestCheckResourceAttr("quicknode_function.test", "source_code_hash", sourceCodeHash("function main(params) { return { params }; }")),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestFunctionResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewFunctionResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "function-1"),
+	})
+}
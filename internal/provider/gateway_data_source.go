@@ -10,6 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jmtx1020/go_quicknode/api/ipfs/gateway"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/ipfsgateway"
 )
 
 var (
@@ -66,10 +69,12 @@ func (g *gatewayDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed: true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was created.",
 				Computed:    true,
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was last updated.",
 				Computed:    true,
 			},
@@ -78,10 +83,10 @@ func (g *gatewayDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 }
 
 func (g *gatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state gatewayResourceModel
+	var state gatewayModel
 	req.Config.GetAttribute(ctx, path.Root("name"), &state.Name)
 
-	gatewayAPI := &gateway.GatewayAPI{API: g.client}
+	gatewayAPI := &gateway.GatewayAPI{API: api.WithContext(ctx, g.client)}
 	gateway, err := gatewayAPI.GetGetwayByName(state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -89,14 +94,14 @@ func (g *gatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			err.Error())
 	}
 
-	state.ID = types.StringValue(fmt.Sprintf("%v", gateway.ID))
+	state.ID = types.StringValue(ipfsgateway.FormatID(gateway.ID))
 	state.UUID = types.StringValue(gateway.UUID)
 	state.Domain = types.StringValue(gateway.Domain)
 	state.Status = types.StringValue(gateway.Status)
 	state.IsEnabled = types.BoolValue(gateway.IsEnabled)
 	state.IsPrivate = types.BoolValue(gateway.IsPrivate)
-	state.CreatedAt = types.StringValue(gateway.CreatedAT.Format("2006-01-02 15:04:05"))
-	state.UpdatedAt = types.StringValue(gateway.UpdatedAt.Format("2006-01-02 15:04:05"))
+	state.CreatedAt = newTimestampValue(gateway.CreatedAT)
+	state.UpdatedAt = newTimestampValue(gateway.UpdatedAt)
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
@@ -111,14 +116,14 @@ func (g *gatewayDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	g.client = apiWrapper
+	g.client = data.client
 }
@@ -3,19 +3,38 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	gateways "github.com/jmtx1020/go_quicknode/api/ipfs/gateway"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/ipfsgateway"
 )
 
 var (
-	_ resource.Resource                = &gatewayResource{}
-	_ resource.ResourceWithConfigure   = &gatewayResource{}
-	_ resource.ResourceWithImportState = &gatewayResource{}
+	_ resource.Resource                   = &gatewayResource{}
+	_ resource.ResourceWithConfigure      = &gatewayResource{}
+	_ resource.ResourceWithImportState    = &gatewayResource{}
+	_ resource.ResourceWithModifyPlan     = &gatewayResource{}
+	_ resource.ResourceWithUpgradeState   = &gatewayResource{}
+	_ resource.ResourceWithValidateConfig = &gatewayResource{}
 )
 
 type gatewayResource struct {
@@ -27,15 +46,42 @@ func NewGatewayResource() resource.Resource {
 }
 
 type gatewayResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	UUID      types.String `tfsdk:"uuid"`
-	Name      types.String `tfsdk:"name"`
-	Domain    types.String `tfsdk:"domain"`
-	Status    types.String `tfsdk:"status"`
-	IsPrivate types.Bool   `tfsdk:"private"`
-	IsEnabled types.Bool   `tfsdk:"enabled"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID           types.String   `tfsdk:"id"`
+	UUID         types.String   `tfsdk:"uuid"`
+	Name         types.String   `tfsdk:"name"`
+	Domain       types.String   `tfsdk:"domain"`
+	Status       types.String   `tfsdk:"status"`
+	IsPrivate    types.Bool     `tfsdk:"private"`
+	IsEnabled    types.Bool     `tfsdk:"enabled"`
+	CreatedAt    timestampValue `tfsdk:"created_at"`
+	UpdatedAt    timestampValue `tfsdk:"updated_at"`
+	WaitForReady types.Bool     `tfsdk:"wait_for_ready"`
+	MovedFrom    types.String   `tfsdk:"moved_from"`
+	MovedFromID  types.String   `tfsdk:"moved_from_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// gatewayPendingStatuses are the statuses of a gateway that is still being
+// provisioned or updated. Any other status is settled.
+var gatewayPendingStatuses = []string{"pending", "provisioning", "creating", "updating", "in_progress"}
+
+// gatewayFailedStatuses are the statuses of a gateway that failed to provision.
+var gatewayFailedStatuses = []string{"failed", "error"}
+
+// gatewayPollInterval is the time between two status checks of a gateway.
+var gatewayPollInterval = 5 * time.Second
+
+// fromGateway sets the attributes returned by the API.
+func (m *gatewayResourceModel) fromGateway(gateway *gateways.Gateway) {
+	m.ID = types.StringValue(ipfsgateway.FormatID(gateway.ID))
+	m.UUID = types.StringValue(gateway.UUID)
+	m.Name = types.StringValue(gateway.Name)
+	m.Domain = types.StringValue(gateway.Domain)
+	m.Status = types.StringValue(gateway.Status)
+	m.IsEnabled = types.BoolValue(gateway.IsEnabled)
+	m.IsPrivate = types.BoolValue(gateway.IsPrivate)
+	m.CreatedAt = newTimestampValue(gateway.CreatedAT)
+	m.UpdatedAt = newTimestampValue(gateway.UpdatedAt)
 }
 
 // Configure adds the provider configured client to the resource.
@@ -44,37 +90,53 @@ func (g *gatewayResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	g.client = apiWrapper
+	g.client = data.client
 }
 
 func (g *gatewayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gateway"
 }
 
-func (g *gatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (g *gatewayResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "An integer that represents the unique identifier of a specified gateway.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"uuid": schema.StringAttribute{
 				Description: `A string that represents the universally unique identifier (UUID) of the new dedicated gateway.
 				UUIDs are used to identify resources uniquely.`,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "A string that specifies the name of the specified gateway. It is a human-readable identifier for the gateway.",
-				Required:    true,
+				Description: "A string that specifies the name of the specified gateway. It is a human-readable identifier for the gateway. " +
+					"It is used as a subdomain, so it may only contain lowercase letters, digits and hyphens and must not start or end with a hyphen.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(gatewayNameRegexp, "must only contain lowercase letters, digits and hyphens and must not start or end with a hyphen"),
+				},
+				// the API cannot rename a gateway
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"domain": schema.StringAttribute{
 				Description: "The domain associated with the gateway.",
@@ -87,23 +149,67 @@ func (g *gatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"private": schema.BoolAttribute{
 				Description: `A boolean value that indicates whether the specified gateway is private or not.
 				If set to true, the gateway is private and not publicly accessible.
-				If set to false, the gateway is public and can be accessed by authorized users isEnabled.`,
-				Required: true,
+				If set to false, the gateway is public and can be accessed by authorized users isEnabled.
+				Required unless moved_from is set, which defaults it to the setting of the moved gateway.`,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Description: `A boolean value that indicates whether the specified gateway is enabled or not.
 				If set to true, it means the gateway is currently enabled and operational.
-				If set to false, it means the gateway is disabled and not functioning`,
-				Required: true,
+				If set to false, it means the gateway is disabled and not functioning.
+				Required unless moved_from is set, which defaults it to the setting of the moved gateway.`,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
-				Description: "The date and time the destination was created.",
+				CustomType:  timestampType{},
+				Description: "The date and time the gateway was created.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
-				Description: "The date and time the destination was last updated.",
+				CustomType:  timestampType{},
+				Description: "The date and time the gateway was last updated.",
 				Computed:    true,
 			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "Whether to wait after a create or an update until the gateway is no longer being provisioned. " +
+					"The wait is bounded by the create and update timeouts. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"moved_from": schema.StringAttribute{
+				Description: "The name of an existing gateway this gateway replaces. When the gateway is planned to be created, private and enabled " +
+					"default to the settings of the moved gateway, and the moved gateway is deleted once the new gateway is ready. " +
+					"Planning fails if the moved gateway is needed for the settings but no longer exists. " +
+					"Changing or removing it later has no effect, so remove it once the gateway was moved.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(gatewayNameRegexp, "must only contain lowercase letters, digits and hyphens and must not start or end with a hyphen"),
+				},
+			},
+			"moved_from_id": schema.StringAttribute{
+				Description: "The ID of the gateway named by moved_from when the gateway was planned to be created. " +
+					"Only a gateway with this ID is deleted, so a gateway later created with the same name is kept.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
 }
@@ -116,7 +222,24 @@ func (g *gatewayResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	gatewayAPI := &gateways.GatewayAPI{API: g.client}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// moved_from was only known at apply time
+	if plan.MovedFromID.IsUnknown() {
+		resp.Diagnostics.Append(g.planMovedGateway(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	gatewayAPI := &gateways.GatewayAPI{API: api.WithContext(ctx, g.client)}
 	gateway, err := gatewayAPI.CreateGateway(
 		plan.Name.ValueString(),
 		plan.IsPrivate.ValueBool(),
@@ -129,15 +252,32 @@ func (g *gatewayResource) Create(ctx context.Context, req resource.CreateRequest
 		)
 		return
 	}
+	plan.fromGateway(gateway)
 
-	plan.ID = types.StringValue(fmt.Sprintf("%v", gateway.ID))
-	plan.Domain = types.StringValue(gateway.Domain)
-	plan.UUID = types.StringValue(gateway.UUID)
-	plan.IsEnabled = types.BoolValue(gateway.IsEnabled)
-	plan.IsPrivate = types.BoolValue(gateway.IsPrivate)
-	plan.Status = types.StringValue(gateway.Status)
-	plan.CreatedAt = types.StringValue(gateway.CreatedAT.Format("2006-01-02 15:04:05"))
-	plan.UpdatedAt = types.StringValue(gateway.UpdatedAt.Format("2006-01-02 15:04:05"))
+	if plan.WaitForReady.ValueBool() {
+		gateway, err = g.waitForGateway(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Waiting for QuickNode Gateway",
+				"Gateway "+plan.Name.ValueString()+" was created but did not become ready: "+err.Error(),
+			)
+			// keep the gateway in state, Terraform marks it as tainted
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+		plan.fromGateway(gateway)
+	}
+
+	if plan.MovedFromID.ValueString() != "" {
+		err = g.deleteMovedGateway(ctx, plan.MovedFrom.ValueString(), plan.MovedFromID.ValueString())
+		if err != nil {
+			// the new gateway is usable, so only warn about the old one
+			resp.Diagnostics.AddWarning(
+				"Error Deleting Moved QuickNode Gateway",
+				"Gateway "+plan.Name.ValueString()+" was created but the gateway "+plan.MovedFrom.ValueString()+" it replaces could not be deleted: "+err.Error(),
+			)
+		}
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -146,6 +286,130 @@ func (g *gatewayResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 }
 
+// ModifyPlan looks up the gateway named by moved_from when the gateway is
+// created, so that a missing moved gateway fails the plan before a renamed
+// gateway is destroyed.
+func (g *gatewayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// replacements are planned again without a prior state, so only creates
+	// look up the moved gateway
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan gatewayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the provider is not configured yet when its configuration is unknown
+	if plan.MovedFrom.IsUnknown() || g.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(g.planMovedGateway(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// planMovedGateway sets moved_from_id to the ID of the gateway named by
+// moved_from, and the private and enabled attributes left unset in the
+// configuration to its settings. A moved gateway that no longer exists was
+// already replaced, so it is ignored when both attributes are set.
+func (g *gatewayResource) planMovedGateway(ctx context.Context, plan *gatewayResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	plan.MovedFromID = types.StringNull()
+	movedFrom := plan.MovedFrom.ValueString()
+	if movedFrom == "" {
+		return diags
+	}
+
+	gatewayAPI := &ipfsgateway.GatewayAPI{API: api.WithContext(ctx, g.client)}
+	moved, err := gatewayAPI.GetGatewayByName(movedFrom)
+	if err != nil {
+		if api.IsNotFound(err) && !plan.IsPrivate.IsUnknown() && !plan.IsEnabled.IsUnknown() {
+			return diags
+		}
+		diags.AddAttributeError(
+			path.Root("moved_from"),
+			"Error Reading Moved QuickNode Gateway",
+			"Could not read QuickNode gateway "+movedFrom+": "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.MovedFromID = types.StringValue(ipfsgateway.FormatID(moved.ID))
+	if plan.IsPrivate.IsUnknown() {
+		plan.IsPrivate = types.BoolValue(moved.IsPrivate)
+	}
+	if plan.IsEnabled.IsUnknown() {
+		plan.IsEnabled = types.BoolValue(moved.IsEnabled)
+	}
+	return diags
+}
+
+// deleteMovedGateway deletes the gateway named name if it still has the ID
+// found when the gateway was planned.
+func (g *gatewayResource) deleteMovedGateway(ctx context.Context, name, id string) error {
+	gatewayAPI := &ipfsgateway.GatewayAPI{API: api.WithContext(ctx, g.client)}
+	moved, err := gatewayAPI.GetGatewayByName(name)
+	if err != nil {
+		if api.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if ipfsgateway.FormatID(moved.ID) != id {
+		tflog.Warn(ctx, "Moved QuickNode gateway was replaced since the plan, keeping it", map[string]any{"name": name, "id": ipfsgateway.FormatID(moved.ID)})
+		return nil
+	}
+
+	err = gatewayAPI.DeleteGatewayByName(name)
+	if err != nil && !api.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// ValidateConfig requires private and enabled unless they are copied from the
+// gateway named by moved_from.
+func (g *gatewayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config gatewayResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.MovedFrom.IsNull() {
+		if !config.MovedFrom.IsUnknown() && config.MovedFrom.Equal(config.Name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("moved_from"),
+				"Invalid Moved Gateway",
+				"moved_from must name another gateway than name.",
+			)
+		}
+		return
+	}
+
+	if config.IsPrivate.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private"),
+			"Missing Required Attribute",
+			"The private attribute is required unless moved_from is set.",
+		)
+	}
+	if config.IsEnabled.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enabled"),
+			"Missing Required Attribute",
+			"The enabled attribute is required unless moved_from is set.",
+		)
+	}
+}
+
 func (g *gatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state gatewayResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -154,24 +418,43 @@ func (g *gatewayResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	gatewayAPI := &gateways.GatewayAPI{API: g.client}
-	gateway, err := gatewayAPI.GetGetwayByName(state.Name.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// the ID does not change when the gateway is renamed, gateways imported by
+	// name have no ID yet
+	gatewayAPI := &ipfsgateway.GatewayAPI{API: api.WithContext(ctx, g.client)}
+	var gateway *gateways.Gateway
+	var err error
+	if state.ID.ValueString() != "" {
+		gateway, err = gatewayAPI.GetGatewayByID(state.ID.ValueString())
+	} else {
+		gateway, err = gatewayAPI.GetGatewayByName(state.Name.ValueString())
+	}
 	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading QuickNode Gateway",
-			"Could not read QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read QuickNode gateway "+gatewayIdentifier(state)+": "+err.Error(),
 		)
 		return
 	}
 
-	state.ID = types.StringValue(fmt.Sprintf("%v", gateway.ID))
-	state.UUID = types.StringValue(gateway.UUID)
-	state.Domain = types.StringValue(gateway.Domain)
-	state.Status = types.StringValue(gateway.Status)
-	state.IsEnabled = types.BoolValue(gateway.IsEnabled)
-	state.IsPrivate = types.BoolValue(gateway.IsPrivate)
-	state.CreatedAt = types.StringValue(gateway.CreatedAT.Format("2006-01-02 15:04:05"))
-	state.UpdatedAt = types.StringValue(gateway.UpdatedAt.Format("2006-01-02 15:04:05"))
+	state.fromGateway(gateway)
+	// imported gateways have no wait_for_ready yet
+	if state.WaitForReady.IsNull() {
+		state.WaitForReady = types.BoolValue(true)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -190,23 +473,23 @@ func (g *gatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var state gatewayResourceModel
-	diags = req.State.Get(ctx, &state)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Check if the field that cannot be updated has changed
-	if state.Name != plan.Name {
-		// Add a diagnostic to indicate that the field cannot be updated
-		resp.Diagnostics.AddError("Name field cannot be updated", "The 'Name' field cannot be updated in the API")
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-		// Return from the method without making any changes to the API
+	var state gatewayResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	gatewayAPI := &gateways.GatewayAPI{API: g.client}
+	gatewayAPI := &gateways.GatewayAPI{API: api.WithContext(ctx, g.client)}
 	gateway, err := gatewayAPI.UpdateGatewayByName(
 		state.Name.ValueString(),
 		plan.IsPrivate.ValueBool(),
@@ -219,16 +502,20 @@ func (g *gatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 		)
 		return
 	}
+	plan.fromGateway(gateway)
 
-	plan.ID = types.StringValue(fmt.Sprintf("%v", gateway.ID))
-	plan.UUID = types.StringValue(gateway.UUID)
-	plan.Name = types.StringValue(gateway.Name)
-	plan.Domain = types.StringValue(gateway.Domain)
-	plan.Status = types.StringValue(gateway.Status)
-	plan.IsEnabled = types.BoolValue(gateway.IsEnabled)
-	plan.IsPrivate = types.BoolValue(gateway.IsPrivate)
-	plan.CreatedAt = types.StringValue(gateway.CreatedAT.Format("2006-01-02 15:04:05"))
-	plan.UpdatedAt = types.StringValue(gateway.UpdatedAt.Format("2006-01-02 15:04:05"))
+	if plan.WaitForReady.ValueBool() {
+		gateway, err = g.waitForGateway(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Waiting for QuickNode Gateway",
+				"Gateway "+plan.Name.ValueString()+" was updated but did not become ready: "+err.Error(),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+		plan.fromGateway(gateway)
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -246,9 +533,18 @@ func (g *gatewayResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	gatewayAPI := &gateways.GatewayAPI{API: g.client}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	gatewayAPI := &ipfsgateway.GatewayAPI{API: api.WithContext(ctx, g.client)}
 	err := gatewayAPI.DeleteGatewayByName(state.Name.ValueString())
-	if err != nil {
+	if err != nil && !api.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting QuickNode Gateway"+state.ID.ValueString(),
 			"Could not delete gateway, unexpected error: "+err.Error(),
@@ -257,7 +553,64 @@ func (g *gatewayResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// waitForGateway polls the gateway until its status is no longer pending and
+// returns it. Failed statuses and ctx being done are errors.
+func (g *gatewayResource) waitForGateway(ctx context.Context, name string) (*gateways.Gateway, error) {
+	gatewayAPI := &ipfsgateway.GatewayAPI{API: api.WithContext(ctx, g.client)}
+	for {
+		gateway, err := gatewayAPI.GetGatewayByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		status := strings.ToLower(gateway.Status)
+		if slices.Contains(gatewayFailedStatuses, status) {
+			return nil, fmt.Errorf("gateway status is %s", gateway.Status)
+		}
+		if !slices.Contains(gatewayPendingStatuses, status) {
+			tflog.Info(ctx, "QuickNode gateway is ready", map[string]any{"name": name, "status": gateway.Status})
+			return gateway, nil
+		}
+
+		tflog.Info(ctx, "Waiting for QuickNode gateway to be ready", map[string]any{"name": name, "status": gateway.Status})
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("gateway status is still %s: %w", gateway.Status, ctx.Err())
+		case <-time.After(gatewayPollInterval):
+		}
+	}
+}
+
+// UpgradeState converts the timestamps of version 0 state to RFC3339.
+func (r *gatewayResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return timestampStateUpgraders("created_at", "updated_at")
+}
+
+// ImportState accepts either the numeric ID of a gateway or its name prefixed
+// with "name:". Read resolves the other one.
 func (r *gatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if name, ok := strings.CutPrefix(req.ID, gatewayImportNamePrefix); ok && name != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+		return
+	}
+
+	if _, err := strconv.ParseUint(req.ID, 10, 64); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a numeric gateway ID or %s<gateway-name>, got: %q", gatewayImportNamePrefix, req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// gatewayImportNamePrefix prefixes the import ID of a gateway imported by name.
+const gatewayImportNamePrefix = "name:"
+
+// gatewayIdentifier returns how a gateway is identified in error messages.
+func gatewayIdentifier(m gatewayResourceModel) string {
+	if m.ID.ValueString() != "" {
+		return m.ID.ValueString()
+	}
+	return gatewayImportNamePrefix + m.Name.ValueString()
 }
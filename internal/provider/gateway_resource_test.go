@@ -1,11 +1,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jmtx1020/go_quicknode/client"
 )
 
 const (
@@ -14,17 +27,23 @@ const (
 )
 
 func TestGatewayResource(t *testing.T) {
+	name := "test-gateway-" + randomString(length)
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: providerConfig + fmt.Sprintf(`
 				resource "quicknode_gateway" "test" {
-				  name    = "test-gateway-%s"
+				  name    = "%s"
 				  private = true
 				  enabled = false
+
+				  timeouts {
+				    create = "5m"
+				    delete = "5m"
+				  }
 				}
-				`, randomString(length)),
+				`, name),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("quicknode_gateway.test", "id"),
 					resource.TestCheckResourceAttrSet("quicknode_gateway.test", "enabled"),
@@ -32,8 +51,42 @@ func TestGatewayResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("quicknode_gateway.test", "status"),
 					resource.TestCheckResourceAttrSet("quicknode_gateway.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("quicknode_gateway.test", "created_at"),
+					resource.TestCheckResourceAttr("quicknode_gateway.test", "timeouts.create", "5m"),
+					resource.TestCheckResourceAttr("quicknode_gateway.test", "wait_for_ready", "true"),
 				),
 			},
+			// ImportState testing by ID and by name
+			{
+				ResourceName:            "quicknode_gateway.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				ResourceName: "quicknode_gateway.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return gatewayImportNamePrefix + s.RootModule().Resources["quicknode_gateway.test"].Primary.Attributes["name"], nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Renaming replaces the gateway
+			{
+				Config: providerConfig + fmt.Sprintf(`
+				resource "quicknode_gateway" "test" {
+				  name    = "%s-renamed"
+				  private = true
+				  enabled = false
+				}
+				`, name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("quicknode_gateway.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("quicknode_gateway.test", "name", name+"-renamed"),
+			},
 		},
 	})
 }
@@ -45,3 +98,295 @@ func randomString(length int) string {
 	}
 	return string(b)
 }
+
+func TestGatewayResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewGatewayResource(), map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "my-gateway"),
+	})
+}
+
+// statusTransport answers every request with a gateway in the next of its
+// statuses, repeating the last one.
+type statusTransport struct {
+	statuses []string
+	calls    int
+}
+
+func (s *statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := s.statuses[min(s.calls, len(s.statuses)-1)]
+	s.calls++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"id":1,"name":"my-gateway","status":%q}`, status))),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func TestGatewayWaitForReady(t *testing.T) {
+	defer func(interval time.Duration) { gatewayPollInterval = interval }(gatewayPollInterval)
+	gatewayPollInterval = time.Millisecond
+
+	tests := []struct {
+		name     string
+		statuses []string
+		calls    int
+		wantErr  bool
+	}{
+		{"ready", []string{"active"}, 1, false},
+		{"pending", []string{"pending", "provisioning", "active"}, 3, false},
+		{"failed", []string{"pending", "failed"}, 2, true},
+		{"timeout", []string{"pending"}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &statusTransport{statuses: tt.statuses}
+			g := &gatewayResource{client: &client.APIWrapper{Client: &http.Client{Transport: transport}}}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			gateway, err := g.waitForGateway(ctx, "my-gateway")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("waitForGateway() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && gateway.Status != "active" {
+				t.Errorf("status = %s, want active", gateway.Status)
+			}
+			if tt.calls > 0 && transport.calls != tt.calls {
+				t.Errorf("calls = %d, want %d", transport.calls, tt.calls)
+			}
+		})
+	}
+}
+
+// gatewayTransport answers a gateway list with two gateways and a gateway
+// read by name with the first one.
+type gatewayTransport struct{}
+
+func (gatewayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := `{"id":1000001,"name":"my-gateway","status":"active","isPrivate":true,"isEnabled":true}`
+	if strings.HasSuffix(req.URL.Path, "/gateway") {
+		body = `[{"id":1000001,"name":"my-gateway","status":"active"},{"id":2,"name":"other","status":"active"}]`
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func TestGatewayResourceImport(t *testing.T) {
+	ctx := context.Background()
+	r := &gatewayResource{client: &client.APIWrapper{Client: &http.Client{Transport: gatewayTransport{}}}}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	emptyState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	for _, importID := range []string{"1000001", "name:my-gateway"} {
+		t.Run(importID, func(t *testing.T) {
+			importResp := fwresource.ImportStateResponse{State: emptyState}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: importID}, &importResp)
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+			}
+
+			readResp := fwresource.ReadResponse{State: importResp.State}
+			r.Read(ctx, fwresource.ReadRequest{State: importResp.State}, &readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+			}
+
+			for attribute, want := range map[string]string{"id": "1000001", "name": "my-gateway"} {
+				var got types.String
+				readResp.State.GetAttribute(ctx, path.Root(attribute), &got)
+				if got.ValueString() != want {
+					t.Errorf("%s = %q, want %q", attribute, got.ValueString(), want)
+				}
+			}
+		})
+	}
+
+	for _, importID := range []string{"", "my-gateway", "name:", "-1"} {
+		importResp := fwresource.ImportStateResponse{State: emptyState}
+		r.ImportState(ctx, fwresource.ImportStateRequest{ID: importID}, &importResp)
+		if !importResp.Diagnostics.HasError() {
+			t.Errorf("expected an error importing %q", importID)
+		}
+	}
+}
+
+func TestGatewayResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := NewGatewayResource()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		name   string
+		values map[string]tftypes.Value
+		errors int
+	}{
+		{"settings", map[string]tftypes.Value{
+			"private": tftypes.NewValue(tftypes.Bool, true),
+			"enabled": tftypes.NewValue(tftypes.Bool, false),
+		}, 0},
+		{"missing settings", nil, 2},
+		{"missing enabled", map[string]tftypes.Value{
+			"private": tftypes.NewValue(tftypes.Bool, true),
+		}, 1},
+		{"moved", map[string]tftypes.Value{
+			"moved_from": tftypes.NewValue(tftypes.String, "old-gateway"),
+		}, 0},
+		{"moved from itself", map[string]tftypes.Value{
+			"moved_from": tftypes.NewValue(tftypes.String, "my-gateway"),
+		}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+				if value, ok := tt.values[name]; ok {
+					attributes[name] = value
+				}
+			}
+			attributes["name"] = tftypes.NewValue(tftypes.String, "my-gateway")
+
+			req := fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)},
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+			r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.ErrorsCount() != tt.errors {
+				t.Errorf("errors = %v, want %d", resp.Diagnostics.Errors(), tt.errors)
+			}
+		})
+	}
+}
+
+func TestGatewayPlanMovedGateway(t *testing.T) {
+	ctx := context.Background()
+	g := &gatewayResource{client: &client.APIWrapper{Client: &http.Client{Transport: gatewayTransport{}}}}
+
+	plan := gatewayResourceModel{
+		MovedFrom: types.StringValue("my-gateway"),
+		IsPrivate: types.BoolUnknown(),
+		IsEnabled: types.BoolValue(false),
+	}
+	if diags := g.planMovedGateway(ctx, &plan); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !plan.IsPrivate.ValueBool() {
+		t.Errorf("private = %s, want the setting of the moved gateway", plan.IsPrivate)
+	}
+	if plan.IsEnabled.ValueBool() {
+		t.Errorf("enabled = %s, want the configured setting", plan.IsEnabled)
+	}
+	if plan.MovedFromID.ValueString() != "1000001" {
+		t.Errorf("moved_from_id = %s, want the ID of the moved gateway", plan.MovedFromID)
+	}
+
+	g.client = &client.APIWrapper{Client: &http.Client{Transport: notFoundTransport{}}}
+	if diags := g.planMovedGateway(ctx, &plan); diags.HasError() {
+		t.Errorf("a moved gateway already deleted should be ignored once the settings are known: %v", diags)
+	}
+	if !plan.MovedFromID.IsNull() {
+		t.Errorf("moved_from_id = %s, want null when the moved gateway is already deleted", plan.MovedFromID)
+	}
+	plan.IsEnabled = types.BoolUnknown()
+	if diags := g.planMovedGateway(ctx, &plan); !diags.HasError() {
+		t.Errorf("expected an error when the settings of a missing moved gateway are needed")
+	}
+}
+
+func TestGatewayModifyPlanStaleMovedFrom(t *testing.T) {
+	ctx := context.Background()
+	g := &gatewayResource{client: &client.APIWrapper{Client: &http.Client{Transport: notFoundTransport{}}}}
+
+	var schemaResp fwresource.SchemaResponse
+	g.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	nullState := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+
+	// a rename is planned again without a prior state, long after the gateway
+	// named by moved_from was deleted
+	newPlan := func(settings tftypes.Value) tfsdk.Plan {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+		}
+		attributes["name"] = tftypes.NewValue(tftypes.String, "renamed-gateway")
+		attributes["moved_from"] = tftypes.NewValue(tftypes.String, "old-gateway")
+		attributes["private"] = settings
+		attributes["enabled"] = settings
+		attributes["timeouts"] = tftypes.NewValue(objectType.AttributeTypes["timeouts"], nil)
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+	}
+
+	plan := newPlan(tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue))
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	g.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: nullState}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected the plan to fail when the settings of a missing moved gateway are needed")
+	}
+
+	plan = newPlan(tftypes.NewValue(tftypes.Bool, true))
+	resp = fwresource.ModifyPlanResponse{Plan: plan}
+	g.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: nullState}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var movedFromID types.String
+	resp.Plan.GetAttribute(ctx, path.Root("moved_from_id"), &movedFromID)
+	if !movedFromID.IsNull() {
+		t.Errorf("moved_from_id = %s, want null so that no gateway is deleted", movedFromID)
+	}
+}
+
+// deleteRecordingTransport answers like gatewayTransport and records the
+// paths of delete requests.
+type deleteRecordingTransport struct {
+	deleted []string
+}
+
+func (d *deleteRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodDelete {
+		d.deleted = append(d.deleted, req.URL.Path)
+	}
+	return gatewayTransport{}.RoundTrip(req)
+}
+
+func TestGatewayDeleteMovedGateway(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		id      string
+		deleted int
+	}{
+		{"planned gateway", "1000001", 1},
+		{"gateway created since the plan", "42", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &deleteRecordingTransport{}
+			g := &gatewayResource{client: &client.APIWrapper{Client: &http.Client{Transport: transport}}}
+
+			if err := g.deleteMovedGateway(ctx, "my-gateway", tt.id); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(transport.deleted) != tt.deleted {
+				t.Errorf("deleted %v, want %d deletes", transport.deleted, tt.deleted)
+			}
+		})
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	gateways "github.com/jmtx1020/go_quicknode/api/ipfs/gateway"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/ipfsgateway"
 )
 
 var (
@@ -25,7 +28,19 @@ type gatewaysDataSource struct {
 }
 
 type gatewaysDataSourceModel struct {
-	Gateways []gatewayResourceModel `tfsdk:"gateways"`
+	Gateways []gatewayModel `tfsdk:"gateways"`
+}
+
+type gatewayModel struct {
+	ID        types.String   `tfsdk:"id"`
+	UUID      types.String   `tfsdk:"uuid"`
+	Name      types.String   `tfsdk:"name"`
+	Domain    types.String   `tfsdk:"domain"`
+	Status    types.String   `tfsdk:"status"`
+	IsPrivate types.Bool     `tfsdk:"private"`
+	IsEnabled types.Bool     `tfsdk:"enabled"`
+	CreatedAt timestampValue `tfsdk:"created_at"`
+	UpdatedAt timestampValue `tfsdk:"updated_at"`
 }
 
 func (g *gatewaysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -73,10 +88,12 @@ func (g *gatewaysDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							Computed: true,
 						},
 						"created_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was created.",
 							Computed:    true,
 						},
 						"updated_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was last updated.",
 							Computed:    true,
 						},
@@ -90,7 +107,7 @@ func (g *gatewaysDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 func (g *gatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state gatewaysDataSourceModel
 
-	gatewayAPI := &gateways.GatewayAPI{API: g.client}
+	gatewayAPI := &gateways.GatewayAPI{API: api.WithContext(ctx, g.client)}
 	gateways, err := gatewayAPI.GetAllGateways()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -99,16 +116,16 @@ func (g *gatewaysDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	for _, gateway := range gateways {
-		gatewayState := gatewayResourceModel{
-			ID:        types.StringValue(fmt.Sprintf("%v", gateway.ID)),
+		gatewayState := gatewayModel{
+			ID:        types.StringValue(ipfsgateway.FormatID(gateway.ID)),
 			Domain:    types.StringValue(gateway.Domain),
 			Name:      types.StringValue(gateway.Name),
 			UUID:      types.StringValue(gateway.UUID),
 			IsEnabled: types.BoolValue(gateway.IsEnabled),
 			IsPrivate: types.BoolValue(gateway.IsPrivate),
 			Status:    types.StringValue(gateway.Status),
-			CreatedAt: types.StringValue(gateway.CreatedAT.Format("2006-01-02 15:04:05")),
-			UpdatedAt: types.StringValue(gateway.UpdatedAt.Format("2006-01-02 15:04:05")),
+			CreatedAt: newTimestampValue(gateway.CreatedAT),
+			UpdatedAt: newTimestampValue(gateway.UpdatedAt),
 		}
 
 		state.Gateways = append(state.Gateways, gatewayState)
@@ -127,14 +144,14 @@ func (g *gatewaysDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	g.client = apiWrapper
+	g.client = data.client
 }
@@ -0,0 +1,475 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	ipfs "github.com/jmtx1020/go_quicknode/api/ipfs/pinning"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/pinning"
+)
+
+var (
+	_ resource.Resource               = &ipfsFileResource{}
+	_ resource.ResourceWithConfigure  = &ipfsFileResource{}
+	_ resource.ResourceWithModifyPlan = &ipfsFileResource{}
+)
+
+type ipfsFileResource struct {
+	client *client.APIWrapper
+}
+
+func NewIPFSFileResource() resource.Resource {
+	return &ipfsFileResource{}
+}
+
+type ipfsFileResourceModel struct {
+	ID          types.String                   `tfsdk:"id"`
+	Source      types.String                   `tfsdk:"source"`
+	Key         types.String                   `tfsdk:"key"`
+	ContentType types.String                   `tfsdk:"content_type"`
+	ContentHash types.String                   `tfsdk:"content_hash"`
+	CID         types.String                   `tfsdk:"cid"`
+	Files       map[string]ipfsFileObjectModel `tfsdk:"files"`
+	Timeouts    timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+type ipfsFileObjectModel struct {
+	RequestID types.String `tfsdk:"request_id"`
+	CID       types.String `tfsdk:"cid"`
+}
+
+// defaultKeyModifier plans the key of an ipfs_file resource as the base name
+// of its source when it is not configured, so that RequiresReplace compares
+// the default with the key in the state rather than replacing the upload on
+// every plan.
+type defaultKeyModifier struct{}
+
+func (m defaultKeyModifier) Description(_ context.Context) string {
+	return "defaults to the base name of source"
+}
+
+func (m defaultKeyModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m defaultKeyModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var source types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &source)...)
+	if resp.Diagnostics.HasError() || source.IsUnknown() || source.IsNull() {
+		return
+	}
+	resp.PlanValue = types.StringValue(filepath.Base(source.ValueString()))
+}
+
+// sourceFile is a file below the source of an ipfs_file resource.
+type sourceFile struct {
+	path string
+	// name is the slash separated path relative to the source, or the base
+	// name when the source is a single file.
+	name string
+}
+
+// sourceFiles lists the files of source in lexical order.
+func sourceFiles(source string) ([]sourceFile, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []sourceFile{{path: source, name: filepath.Base(source)}}, nil
+	}
+
+	var files []sourceFile
+	err = filepath.WalkDir(source, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, sourceFile{path: p, name: filepath.ToSlash(name)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("directory %s contains no files", source)
+	}
+	return files, nil
+}
+
+// contentHash returns the hex encoded SHA-256 of the names and contents of files.
+func contentHash(files []sourceFile) (string, error) {
+	hash := sha256.New()
+	for _, file := range files {
+		content, err := os.ReadFile(file.path)
+		if err != nil {
+			return "", err
+		}
+		sum := sha256.Sum256(content)
+		fmt.Fprintf(hash, "%s\x00%x\n", file.name, sum)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (i *ipfsFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	i.client = data.client
+}
+
+// Metadata returns the resource type name.
+func (i *ipfsFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipfs_file"
+}
+
+func (i *ipfsFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a local file or directory to QuickNode IPFS and pins it. Every file of a directory is uploaded and pinned on its own. " +
+			"Changes to the content replace the upload.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The key the content is uploaded under.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The path of the local file or directory to upload.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The key to upload the content under. Files of a directory are uploaded under <key>/<relative path>. Defaults to the base name of source.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					defaultKeyModifier{},
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The content type of the uploaded files. Defaults to a type derived from each file extension.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "The SHA-256 of the names and contents of the uploaded files.",
+				Computed:    true,
+			},
+			"cid": schema.StringAttribute{
+				Description: "The CID of the uploaded file. Not set when source is a directory, see files.",
+				Computed:    true,
+			},
+			"files": schema.MapNestedAttribute{
+				Description: "The uploaded files by their path relative to source.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"request_id": schema.StringAttribute{
+							Description: "The request ID of the pinned object.",
+							Computed:    true,
+						},
+						"cid": schema.StringAttribute{
+							Description: "The CID of the file.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan hashes the local content and replaces the upload when it changed.
+func (i *ipfsFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to hash when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ipfsFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() {
+		return
+	}
+
+	files, err := sourceFiles(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read IPFS Source", err.Error())
+		return
+	}
+	hash, err := contentHash(files)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read IPFS Source", err.Error())
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("content_hash"), hash)
+	resp.Diagnostics.Append(diags...)
+
+	// content addressed uploads can't be changed in place
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ipfsFileResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ContentHash.ValueString() != hash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+}
+
+func (i *ipfsFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipfsFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	source := plan.Source.ValueString()
+	files, err := sourceFiles(source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read IPFS Source", err.Error())
+		return
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read IPFS Source", err.Error())
+		return
+	}
+
+	hash, err := contentHash(files)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read IPFS Source", err.Error())
+		return
+	}
+
+	if plan.Key.IsUnknown() {
+		plan.Key = types.StringValue(filepath.Base(source))
+	}
+
+	plan.ID = plan.Key
+	plan.ContentHash = types.StringValue(hash)
+	plan.CID = types.StringNull()
+	plan.Files = map[string]ipfsFileObjectModel{}
+
+	pinningAPI := &ipfs.PinningAPI{API: api.WithContext(ctx, i.client)}
+	for _, file := range files {
+		content, err := os.ReadFile(file.path)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to Read IPFS Source", err.Error())
+			break
+		}
+
+		key := plan.Key.ValueString()
+		if info.IsDir() {
+			key += "/" + file.name
+		}
+
+		contentType := plan.ContentType.ValueString()
+		if contentType == "" {
+			contentType = mime.TypeByExtension(filepath.Ext(file.name))
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		upload, err := pinningAPI.UploadObject(content, key, contentType)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error uploading IPFS file",
+				"Could not upload "+file.path+", unexpected error: "+err.Error(),
+			)
+			break
+		}
+
+		plan.Files[file.name] = ipfsFileObjectModel{
+			RequestID: types.StringValue(upload.RequestID),
+			CID:       types.StringValue(upload.Pin.CID),
+		}
+		if !info.IsDir() {
+			plan.CID = types.StringValue(upload.Pin.CID)
+		}
+	}
+
+	// the state is saved even after a failed upload so the files uploaded
+	// so far are removed when the tainted resource is replaced
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (i *ipfsFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ipfsFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	pinningAPI := &pinning.PinningAPI{API: api.WithContext(ctx, i.client)}
+	for name, file := range state.Files {
+		pinnedObject, err := pinningAPI.GetPinnedObjectByRequestID(file.RequestID.ValueString())
+		if err != nil {
+			// a file unpinned outside Terraform is uploaded again
+			if api.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading QuickNode IPFS File",
+				"Could not read QuickNode ID "+file.RequestID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		file.CID = types.StringValue(pinnedObject.CID)
+		state.Files[name] = file
+		if !state.CID.IsNull() {
+			state.CID = file.CID
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (i *ipfsFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every change replaces the upload, nothing is left to update
+	var plan ipfsFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ipfsFileResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.CID = state.CID
+	plan.Files = state.Files
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (i *ipfsFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ipfsFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	pinningAPI := &pinning.PinningAPI{API: api.WithContext(ctx, i.client)}
+	for _, file := range state.Files {
+		// A file that is already unpinned counts as deleted
+		err := pinningAPI.DeletePinnedObject(file.RequestID.ValueString())
+		if err != nil && !api.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting QuickNode IPFS File "+file.RequestID.ValueString(),
+				"Could not delete IPFS file, unexpected error: "+err.Error(),
+			)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIPFSFileResource(t *testing.T) {
+	dir := t.TempDir()
+	index := filepath.Join(dir, "index.html")
+	if err := os.WriteFile(index, []byte("<h1>v1</h1>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "app.js"), []byte("console.log('v1');"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := providerConfig + fmt.Sprintf(`
+	resource "quicknode_ipfs_file" "test" {
+		source = %q
+		key    = "tf-testing-site"
+	}
+	`, dir)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_ipfs_file.test", "id", "tf-testing-site"),
+					resource.TestCheckResourceAttrSet("quicknode_ipfs_file.test", "content_hash"),
+					resource.TestCheckResourceAttr("quicknode_ipfs_file.test", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("quicknode_ipfs_file.test", "files.index.html.cid"),
+					resource.TestCheckNoResourceAttr("quicknode_ipfs_file.test", "cid"),
+				),
+			},
+			// Local edits replace the upload
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(index, []byte("<h1>v2</h1>"), 0o644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_ipfs_file.test", "files.%", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDefaultKeyModifier(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	(&ipfsFileResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	newPlan := func(source tftypes.Value) tfsdk.Plan {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		attributes["source"] = source
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+	}
+
+	tests := []struct {
+		name   string
+		source tftypes.Value
+		config types.String
+		want   types.String
+	}{
+		{"default", tftypes.NewValue(tftypes.String, "site/index.html"), types.StringNull(), types.StringValue("index.html")},
+		{"configured", tftypes.NewValue(tftypes.String, "site/index.html"), types.StringValue("home.html"), types.StringValue("home.html")},
+		{"unknown source", tftypes.NewValue(tftypes.String, tftypes.UnknownValue), types.StringNull(), types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planValue := tt.config
+			if planValue.IsNull() {
+				planValue = types.StringUnknown()
+			}
+			req := planmodifier.StringRequest{Plan: newPlan(tt.source), ConfigValue: tt.config, PlanValue: planValue}
+			resp := planmodifier.StringResponse{PlanValue: planValue}
+			defaultKeyModifier{}.PlanModifyString(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("key = %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPFSFileResourceNotFound(t *testing.T) {
+	fileType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"request_id": tftypes.String,
+		"cid":        tftypes.String,
+	}}
+	testResourceNotFound(t, NewIPFSFileResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "my-dir"),
+		"files": tftypes.NewValue(tftypes.Map{ElementType: fileType}, map[string]tftypes.Value{
+			"a.txt": tftypes.NewValue(fileType, map[string]tftypes.Value{
+				"request_id": tftypes.NewValue(tftypes.String, "request-1"),
+				"cid":        tftypes.NewValue(tftypes.String, "cid-1"),
+			}),
+		}),
+	})
+}
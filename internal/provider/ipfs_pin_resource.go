@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/pinning"
+)
+
+var (
+	_ resource.Resource                   = &ipfsPinResource{}
+	_ resource.ResourceWithConfigure      = &ipfsPinResource{}
+	_ resource.ResourceWithImportState    = &ipfsPinResource{}
+	_ resource.ResourceWithModifyPlan     = &ipfsPinResource{}
+	_ resource.ResourceWithValidateConfig = &ipfsPinResource{}
+)
+
+type ipfsPinResource struct {
+	client        *client.APIWrapper
+	defaultLabels map[string]string
+}
+
+func NewIPFSPinResource() resource.Resource {
+	return &ipfsPinResource{}
+}
+
+type ipfsPinResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	CID       types.String   `tfsdk:"cid"`
+	Name      types.String   `tfsdk:"name"`
+	Origins   []types.String `tfsdk:"origins"`
+	Metadata  types.Map      `tfsdk:"metadata"`
+	Labels    types.Map      `tfsdk:"labels"`
+	LabelsAll types.Map      `tfsdk:"labels_all"`
+	Status    types.String   `tfsdk:"status"`
+	CreatedAt timestampValue `tfsdk:"created_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// payload converts the model to the API payload.
+func (m ipfsPinResourceModel) payload(ctx context.Context) (pinning.PinnedObjectPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert []types.String to []string
+	origins := make([]string, len(m.Origins))
+	for i, origin := range m.Origins {
+		origins[i] = origin.ValueString()
+	}
+
+	meta := map[string]string{}
+	if !m.Metadata.IsNull() {
+		diags.Append(m.Metadata.ElementsAs(ctx, &meta, false)...)
+	}
+	var labels map[string]string
+	diags.Append(m.LabelsAll.ElementsAs(ctx, &labels, false)...)
+	for key, value := range labels {
+		meta[pinLabelPrefix+key] = value
+	}
+
+	return pinning.PinnedObjectPayload{
+		CID:     m.CID.ValueString(),
+		Name:    m.Name.ValueString(),
+		Origins: origins,
+		Meta:    meta,
+	}, diags
+}
+
+// pinLabelPrefix prefixes the keys of the labels stored in the metadata of a
+// pinned object.
+const pinLabelPrefix = "label:"
+
+// splitPinMeta splits the metadata of a pinned object into the metadata set
+// with the metadata attribute and the labels.
+func splitPinMeta(meta map[string]string) (map[string]string, map[string]string) {
+	var metadata, labels map[string]string
+	for key, value := range meta {
+		if label, ok := strings.CutPrefix(key, pinLabelPrefix); ok {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[label] = value
+			continue
+		}
+		if metadata == nil {
+			metadata = map[string]string{}
+		}
+		metadata[key] = value
+	}
+	return metadata, labels
+}
+
+// Configure adds the provider configured client to the resource.
+func (i *ipfsPinResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	i.client = data.client
+	i.defaultLabels = data.defaultLabels
+}
+
+// Metadata returns the resource type name.
+func (i *ipfsPinResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipfs_pin"
+}
+
+func (i *ipfsPinResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	labels := labelsAttribute("metadata")
+	labels.Description = "Labels such as team, environment or cost-center, merged with the default_labels of the provider. " +
+		"They are stored in the metadata of the pinned object under keys prefixed with \"" + pinLabelPrefix + "\". " +
+		"Keys and values may only contain lowercase letters, digits, underscores and hyphens."
+
+	resp.Schema = schema.Schema{
+		Description: "Pins content that is already available on IPFS by its CID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The request ID of the pinned object.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cid": schema.StringAttribute{
+				Description: "The CID of the content to pin. Changing this forces a new pin to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the pinned object.",
+				Required:    true,
+			},
+			"origins": schema.ListAttribute{
+				Description: "Multiaddresses of peers known to provide the content.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Key value metadata stored with the pinned object. Keys prefixed with \"" + pinLabelPrefix + "\" are reserved for labels.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"labels":     labels,
+			"labels_all": labelsAllAttribute(),
+			"status": schema.StringAttribute{
+				Description: "The pinning status of the object, e.g. \"queued\", \"pinning\" or \"pinned\".",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:  timestampType{},
+				Description: "The date and time the object was pinned.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ModifyPlan merges the default labels into labels_all.
+func (i *ipfsPinResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanLabels(ctx, i.defaultLabels, req, resp)
+}
+
+// ValidateConfig keeps metadata keys from being read back as labels.
+func (i *ipfsPinResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var metadata types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metadata"), &metadata)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key := range metadata.Elements() {
+		if strings.HasPrefix(key, pinLabelPrefix) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("metadata").AtMapKey(key),
+				"Reserved Metadata Key",
+				"Metadata keys prefixed with \""+pinLabelPrefix+"\" are reserved for labels, set the label with the labels attribute instead.",
+			)
+		}
+	}
+}
+
+func (i *ipfsPinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipfsPinResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pinningAPI := &pinning.PinningAPI{API: api.WithContext(ctx, i.client)}
+	pinnedObject, err := pinningAPI.CreatePinnedObject(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating IPFS pin",
+			"Could not create IPFS pin, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(pinnedObject.RequestID)
+	plan.Status = types.StringValue(pinnedObject.Status)
+	plan.CreatedAt = newTimestampValue(pinnedObject.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (i *ipfsPinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ipfsPinResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	pinningAPI := &pinning.PinningAPI{API: api.WithContext(ctx, i.client)}
+	pinnedObject, err := pinningAPI.GetPinnedObjectByRequestID(state.ID.ValueString())
+	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode IPFS Pin",
+			"Could not read QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.CID = types.StringValue(pinnedObject.CID)
+	state.Name = types.StringValue(pinnedObject.Name)
+	state.Status = types.StringValue(pinnedObject.Status)
+	state.CreatedAt = newTimestampValue(pinnedObject.CreatedAt)
+
+	// the API returns a single empty origin when none were given
+	var origins []types.String
+	for _, origin := range pinnedObject.Origins {
+		if origin != "" {
+			origins = append(origins, types.StringValue(origin))
+		}
+	}
+	if len(origins) > 0 || state.Origins != nil {
+		state.Origins = origins
+	}
+
+	metadata, labels := splitPinMeta(pinnedObject.Meta)
+	if len(metadata) > 0 || !state.Metadata.IsNull() {
+		metadataValue, diags := types.MapValueFrom(ctx, types.StringType, metadata)
+		resp.Diagnostics.Append(diags...)
+		state.Metadata = metadataValue
+	}
+	readLabels(i.defaultLabels, labels, &state.Labels, &state.LabelsAll)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (i *ipfsPinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ipfsPinResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state ipfsPinResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pinningAPI := &pinning.PinningAPI{API: api.WithContext(ctx, i.client)}
+	pinnedObject, err := pinningAPI.UpdatePinnedObject(state.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating QuickNode IPFS Pin.",
+			"Could not update QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Status = types.StringValue(pinnedObject.Status)
+	plan.CreatedAt = state.CreatedAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (i *ipfsPinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ipfsPinResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	pinningAPI := &pinning.PinningAPI{API: api.WithContext(ctx, i.client)}
+	// An IPFS pin that is already gone counts as deleted
+	err := pinningAPI.DeletePinnedObject(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode IPFS Pin "+state.ID.ValueString(),
+			"Could not delete IPFS pin, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (i *ipfsPinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestIPFSPinResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_ipfs_pin" "test" {
+					cid  = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+					name = "tf-testing-pin"
+
+					metadata = {
+						env = "test"
+					}
+
+					labels = {
+						team = "testing"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_ipfs_pin.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_ipfs_pin.test", "name", "tf-testing-pin"),
+					resource.TestCheckResourceAttr("quicknode_ipfs_pin.test", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("quicknode_ipfs_pin.test", "metadata.env", "test"),
+					resource.TestCheckResourceAttr("quicknode_ipfs_pin.test", "labels_all.team", "testing"),
+					resource.TestCheckResourceAttrSet("quicknode_ipfs_pin.test", "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "quicknode_ipfs_pin.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_ipfs_pin" "test" {
+					cid  = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+					name = "tf-testing-pin-updated"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_ipfs_pin.test", "name", "tf-testing-pin-updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestIPFSPinLabels(t *testing.T) {
+	ctx := context.Background()
+
+	model := ipfsPinResourceModel{
+		Metadata:  newLabelsValue(map[string]string{"env": "test"}),
+		LabelsAll: newLabelsValue(map[string]string{"team": "payments"}),
+	}
+	payload, diags := model.payload(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := map[string]string{"env": "test", "label:team": "payments"}; !maps.Equal(payload.Meta, want) {
+		t.Errorf("meta = %v, want %v", payload.Meta, want)
+	}
+
+	metadata, labels := splitPinMeta(payload.Meta)
+	if want := map[string]string{"env": "test"}; !maps.Equal(metadata, want) {
+		t.Errorf("metadata = %v, want %v", metadata, want)
+	}
+	if want := map[string]string{"team": "payments"}; !maps.Equal(labels, want) {
+		t.Errorf("labels = %v, want %v", labels, want)
+	}
+}
+
+func TestIPFSPinResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := NewIPFSPinResource()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for key, errors := range map[string]int{"env": 0, "label:team": 1} {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		attributes["metadata"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			key: tftypes.NewValue(tftypes.String, "test"),
+		})
+
+		req := fwresource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)},
+		}
+		resp := &fwresource.ValidateConfigResponse{}
+		r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, req, resp)
+		if resp.Diagnostics.ErrorsCount() != errors {
+			t.Errorf("metadata key %q: errors = %v, want %d", key, resp.Diagnostics.Errors(), errors)
+		}
+	}
+}
+
+func TestIPFSPinResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewIPFSPinResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "request-1"),
+	})
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/kv"
+)
+
+var (
+	_ resource.Resource                = &kvListResource{}
+	_ resource.ResourceWithConfigure   = &kvListResource{}
+	_ resource.ResourceWithImportState = &kvListResource{}
+)
+
+type kvListResource struct {
+	client *client.APIWrapper
+}
+
+func NewKVListResource() resource.Resource {
+	return &kvListResource{}
+}
+
+type kvListResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Key      types.String   `tfsdk:"key"`
+	Items    []types.String `tfsdk:"items"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// items converts the model items to []string.
+func (m kvListResourceModel) items() []string {
+	items := make([]string, len(m.Items))
+	for i, item := range m.Items {
+		items[i] = item.ValueString()
+	}
+	return items
+}
+
+// diffItems returns the items of want missing from have and the items of have
+// missing from want.
+func diffItems(have, want []string) (add, remove []string) {
+	haveItems := make(map[string]bool, len(have))
+	for _, item := range have {
+		haveItems[item] = true
+	}
+	wantItems := make(map[string]bool, len(want))
+	for _, item := range want {
+		wantItems[item] = true
+		if !haveItems[item] {
+			add = append(add, item)
+		}
+	}
+	for _, item := range have {
+		if !wantItems[item] {
+			remove = append(remove, item)
+		}
+	}
+	return add, remove
+}
+
+// Configure adds the provider configured client to the resource.
+func (k *kvListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	k.client = data.client
+}
+
+// Metadata returns the resource type name.
+func (k *kvListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_list"
+}
+
+func (k *kvListResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a list in the QuickNode Key-Value Store. Changes to the items are applied item by item.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The key of the list.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The key of the list. Changing this forces a new list to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"items": schema.SetAttribute{
+				Description: "The items of the list.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (k *kvListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan kvListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	kvAPI := &kv.KVAPI{API: api.WithContext(ctx, k.client)}
+	err := kvAPI.CreateList(plan.Key.ValueString(), plan.items())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating key-value list",
+			"Could not create key-value list, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Key
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (k *kvListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state kvListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	kvAPI := &kv.KVAPI{API: api.WithContext(ctx, k.client)}
+	list, err := kvAPI.GetList(state.ID.ValueString())
+	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Key-Value List",
+			"Could not read QuickNode key "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	items := make([]types.String, len(list.Items))
+	for i, item := range list.Items {
+		items[i] = types.StringValue(item)
+	}
+
+	state.Key = state.ID
+	state.Items = items
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (k *kvListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan kvListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state kvListResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	add, remove := diffItems(state.items(), plan.items())
+	if len(add) > 0 || len(remove) > 0 {
+		kvAPI := &kv.KVAPI{API: api.WithContext(ctx, k.client)}
+		err := kvAPI.UpdateList(state.ID.ValueString(), add, remove)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating QuickNode Key-Value List.",
+				"Could not update QuickNode key "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (k *kvListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state kvListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	kvAPI := &kv.KVAPI{API: api.WithContext(ctx, k.client)}
+	// A key-value list that is already gone counts as deleted
+	err := kvAPI.DeleteList(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Key-Value List "+state.ID.ValueString(),
+			"Could not delete key-value list, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (k *kvListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestKVListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_kv_list" "test" {
+					key   = "tf-testing-list"
+					items = ["a", "b", "c"]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_kv_list.test", "id", "tf-testing-list"),
+					resource.TestCheckResourceAttr("quicknode_kv_list.test", "items.#", "3"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_kv_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_kv_list" "test" {
+					key   = "tf-testing-list"
+					items = ["a", "c", "d"]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("quicknode_kv_list.test", "items.*", "d"),
+					resource.TestCheckResourceAttr("quicknode_kv_list.test", "items.#", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDiffItems(t *testing.T) {
+	add, remove := diffItems([]string{"a", "b", "c"}, []string{"c", "d", "a"})
+	if !reflect.DeepEqual(add, []string{"d"}) {
+		t.Errorf("add = %v, want [d]", add)
+	}
+	if !reflect.DeepEqual(remove, []string{"b"}) {
+		t.Errorf("remove = %v, want [b]", remove)
+	}
+
+	add, remove = diffItems([]string{"a"}, []string{"a"})
+	if add != nil || remove != nil {
+		t.Errorf("diffItems of equal items = %v, %v, want no changes", add, remove)
+	}
+}
+
+func TestKVListResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewKVListResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "my-list"),
+	})
+}
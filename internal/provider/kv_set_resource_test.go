@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestKVSetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_kv_set" "test" {
+					key   = "tf-testing-set"
+					value = "one"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_kv_set.test", "id", "tf-testing-set"),
+					resource.TestCheckResourceAttr("quicknode_kv_set.test", "value", "one"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "quicknode_kv_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_kv_set" "test" {
+					key   = "tf-testing-set"
+					value = "two"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_kv_set.test", "value", "two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestKVSetResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewKVSetResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "my-set"),
+	})
+}
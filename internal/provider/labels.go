@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The QuickNode API has no tags, so labels are encoded at the end of the name
+// or the description of objects as " [key=value,key=value]", in sorted order.
+// Keys and values are restricted so that the encoding is unambiguous.
+var (
+	labelKeyRegexp   = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	labelValueRegexp = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+
+	encodedLabelsRegexp = regexp.MustCompile(`(?s)^(?:(.*) )?\[([a-z][a-z0-9_-]*=[a-z0-9_-]*(?:,[a-z][a-z0-9_-]*=[a-z0-9_-]*)*)\]$`)
+)
+
+// labelsValidators validate the keys and values of a labels map.
+var labelsValidators = []validator.Map{
+	mapvalidator.KeysAre(stringvalidator.RegexMatches(labelKeyRegexp, "must start with a lowercase letter and only contain lowercase letters, digits, underscores and hyphens")),
+	mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(labelValueRegexp, "must only contain lowercase letters, digits, underscores and hyphens")),
+}
+
+// encodeLabels appends labels to text.
+func encodeLabels(text string, labels map[string]string) string {
+	if len(labels) == 0 {
+		return text
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	encoded := "[" + strings.Join(pairs, ",") + "]"
+	if text == "" {
+		return encoded
+	}
+	return text + " " + encoded
+}
+
+// decodeLabels splits text into the text without labels and the labels
+// encodeLabels appended to it. Text without labels has nil labels.
+func decodeLabels(text string) (string, map[string]string) {
+	match := encodedLabelsRegexp.FindStringSubmatch(text)
+	if match == nil {
+		return text, nil
+	}
+
+	labels := map[string]string{}
+	for _, pair := range strings.Split(match[2], ",") {
+		key, value, _ := strings.Cut(pair, "=")
+		labels[key] = value
+	}
+	return match[1], labels
+}
+
+// mergeLabels returns the default labels overridden by labels, nil when both
+// are empty.
+func mergeLabels(defaults, labels map[string]string) map[string]string {
+	if len(defaults) == 0 && len(labels) == 0 {
+		return nil
+	}
+
+	merged := maps.Clone(defaults)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	maps.Copy(merged, labels)
+	return merged
+}
+
+// withoutDefaultLabels returns the labels that are not default labels with the
+// same value, nil when there are none.
+func withoutDefaultLabels(defaults, labels map[string]string) map[string]string {
+	var own map[string]string
+	for key, value := range labels {
+		if defaultValue, ok := defaults[key]; ok && defaultValue == value {
+			continue
+		}
+		if own == nil {
+			own = map[string]string{}
+		}
+		own[key] = value
+	}
+	return own
+}
+
+// matchLabels reports whether labels contain every label of filter.
+func matchLabels(labels, filter map[string]string) bool {
+	for key, value := range filter {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// newLabelsValue converts labels to a map value, null when there are none.
+func newLabelsValue(labels map[string]string) types.Map {
+	if len(labels) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(labels))
+	for key, value := range labels {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// labelsAttribute is the labels attribute of a resource whose labels are
+// encoded in field.
+func labelsAttribute(field string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "Labels such as team, environment or cost-center, merged with the default_labels of the provider. " +
+			"The API has no tags, so they are encoded at the end of the " + field + " as \" [key=value,...]\". " +
+			"Keys and values may only contain lowercase letters, digits, underscores and hyphens.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators:  labelsValidators,
+	}
+}
+
+// labelsAllAttribute is the labels_all attribute of a resource, which holds
+// its labels merged with the default labels.
+func labelsAllAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "The labels of the resource merged with the default_labels of the provider.",
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// modifyPlanLabels plans labels_all as the default labels merged with the
+// planned labels, so that changing the default labels updates resources.
+func modifyPlanLabels(ctx context.Context, defaults map[string]string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to merge on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var labels types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+	if resp.Diagnostics.HasError() || labels.IsUnknown() {
+		return
+	}
+	// labels_all stays unknown until every label is known
+	for _, value := range labels.Elements() {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	labelsAll, diags := mergeLabelsValue(ctx, defaults, labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), labelsAll)...)
+}
+
+// readLabels sets labels_all to the labels read from the API. Labels missing
+// from the state, as after an import, are set to the labels read that are
+// not default labels.
+func readLabels(defaults, read map[string]string, labels, labelsAll *types.Map) {
+	*labelsAll = newLabelsValue(read)
+	if labels.IsNull() {
+		*labels = newLabelsValue(withoutDefaultLabels(defaults, read))
+	}
+}
+
+// mergeLabelsValue merges the default labels with a labels attribute.
+func mergeLabelsValue(ctx context.Context, defaults map[string]string, labels types.Map) (types.Map, diag.Diagnostics) {
+	var configured map[string]string
+	diags := labels.ElementsAs(ctx, &configured, false)
+	return newLabelsValue(mergeLabels(defaults, configured)), diags
+}
+
+// encodeLabelsValue appends the labels of a labels_all attribute to text.
+func encodeLabelsValue(ctx context.Context, text string, labels types.Map) (string, diag.Diagnostics) {
+	var labelsAll map[string]string
+	diags := labels.ElementsAs(ctx, &labelsAll, false)
+	return encodeLabels(text, labelsAll), diags
+}
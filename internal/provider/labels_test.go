@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEncodeLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		labels  map[string]string
+		encoded string
+	}{
+		{"no labels", "payments alerts", nil, "payments alerts"},
+		{"sorted", "payments alerts", map[string]string{"team": "payments", "environment": "prod"}, "payments alerts [environment=prod,team=payments]"},
+		{"empty value", "alerts", map[string]string{"cost-center": ""}, "alerts [cost-center=]"},
+		{"empty text", "", map[string]string{"team": "payments"}, "[team=payments]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := encodeLabels(tt.text, tt.labels)
+			if encoded != tt.encoded {
+				t.Fatalf("encodeLabels() = %q, want %q", encoded, tt.encoded)
+			}
+
+			text, labels := decodeLabels(encoded)
+			if text != tt.text || !maps.Equal(labels, tt.labels) {
+				t.Errorf("decodeLabels(%q) = %q, %v, want %q, %v", encoded, text, labels, tt.text, tt.labels)
+			}
+		})
+	}
+}
+
+func TestDecodeLabelsWithoutLabels(t *testing.T) {
+	for _, text := range []string{"alerts", "alerts [draft]", "alerts [Team=payments]", "alerts[team=payments]", "[x] alerts"} {
+		decoded, labels := decodeLabels(text)
+		if decoded != text || labels != nil {
+			t.Errorf("decodeLabels(%q) = %q, %v, want the text unchanged", text, decoded, labels)
+		}
+	}
+}
+
+func TestMergeLabels(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "environment": "prod"}
+
+	merged := mergeLabels(defaults, map[string]string{"team": "payments"})
+	if want := map[string]string{"team": "payments", "environment": "prod"}; !maps.Equal(merged, want) {
+		t.Errorf("mergeLabels() = %v, want %v", merged, want)
+	}
+	if defaults["team"] != "platform" {
+		t.Errorf("mergeLabels() modified the default labels")
+	}
+	if merged := mergeLabels(nil, nil); merged != nil {
+		t.Errorf("mergeLabels(nil, nil) = %v, want nil", merged)
+	}
+}
+
+func TestWithoutDefaultLabels(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "environment": "prod"}
+
+	labels := withoutDefaultLabels(defaults, map[string]string{"team": "payments", "environment": "prod", "cost-center": "42"})
+	if want := map[string]string{"team": "payments", "cost-center": "42"}; !maps.Equal(labels, want) {
+		t.Errorf("withoutDefaultLabels() = %v, want %v", labels, want)
+	}
+	if labels := withoutDefaultLabels(defaults, defaults); labels != nil {
+		t.Errorf("withoutDefaultLabels() = %v, want nil for default labels only", labels)
+	}
+}
+
+func TestReadLabels(t *testing.T) {
+	defaults := map[string]string{"environment": "prod"}
+	read := map[string]string{"team": "payments", "environment": "prod"}
+
+	// imported resources have no labels in the state yet
+	labels, labelsAll := types.MapNull(types.StringType), types.MapNull(types.StringType)
+	readLabels(defaults, read, &labels, &labelsAll)
+	if want := newLabelsValue(map[string]string{"team": "payments"}); !labels.Equal(want) {
+		t.Errorf("labels = %s, want %s", labels, want)
+	}
+	if want := newLabelsValue(read); !labelsAll.Equal(want) {
+		t.Errorf("labels_all = %s, want %s", labelsAll, want)
+	}
+
+	// configured labels are kept, even when they repeat a default label
+	configured := newLabelsValue(map[string]string{"team": "payments", "environment": "prod"})
+	labels = configured
+	readLabels(defaults, read, &labels, &labelsAll)
+	if !labels.Equal(configured) {
+		t.Errorf("labels = %s, want %s", labels, configured)
+	}
+}
+
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{"team": "payments", "environment": "prod"}
+
+	if !matchLabels(labels, nil) {
+		t.Errorf("labels should match an empty filter")
+	}
+	if !matchLabels(labels, map[string]string{"team": "payments"}) {
+		t.Errorf("labels should match a subset of them")
+	}
+	if matchLabels(labels, map[string]string{"team": "platform"}) {
+		t.Errorf("labels should not match another value")
+	}
+	if matchLabels(nil, map[string]string{"team": "payments"}) {
+		t.Errorf("no labels should not match a filter")
+	}
+}
+
+func TestModifyPlanLabels(t *testing.T) {
+	ctx := context.Background()
+	r := &destinationResource{defaultLabels: map[string]string{"team": "platform", "environment": "prod"}}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	newPlan := func(labels map[string]tftypes.Value) tfsdk.Plan {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		attributes["labels"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, labels)
+		attributes["labels_all"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+	}
+
+	plan := newPlan(map[string]tftypes.Value{
+		"team": tftypes.NewValue(tftypes.String, "payments"),
+	})
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var labelsAll map[string]string
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("labels_all"), &labelsAll)...)
+	if want := map[string]string{"team": "payments", "environment": "prod"}; !maps.Equal(labelsAll, want) {
+		t.Errorf("labels_all = %v, want %v", labelsAll, want)
+	}
+
+	// a label computed from another resource is only known at apply time
+	plan = newPlan(map[string]tftypes.Value{
+		"team":  tftypes.NewValue(tftypes.String, "payments"),
+		"owner": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp = resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics with an unknown label: %v", resp.Diagnostics)
+	}
+
+	var unknownLabelsAll types.Map
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("labels_all"), &unknownLabelsAll)...)
+	if !unknownLabelsAll.IsUnknown() {
+		t.Errorf("labels_all = %s, want unknown", unknownLabelsAll)
+	}
+}
+
+func TestNewLabelsValue(t *testing.T) {
+	if value := newLabelsValue(nil); !value.IsNull() {
+		t.Errorf("newLabelsValue(nil) = %s, want null", value)
+	}
+
+	value := newLabelsValue(map[string]string{"team": "payments"})
+	if !value.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")})) {
+		t.Errorf("newLabelsValue() = %s", value)
+	}
+}
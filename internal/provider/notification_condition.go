@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-quicknode/internal/expression"
+)
+
+// conditionDepth is how many levels of all and any blocks can be nested
+// below the condition block.
+const conditionDepth = 3
+
+// conditionBlock returns the schema of the condition block of quicknode_notification.
+func conditionBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "The notification condition as a tree of blocks that is compiled into the expression. " +
+			"Each block either compares a field with a value, or combines nested all (&&) or any (||) blocks.",
+		Attributes: conditionAttributes(),
+		Blocks:     conditionBlocks(conditionDepth),
+	}
+}
+
+func conditionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"field": schema.StringAttribute{
+			Description: "The field to compare, e.g. \"tx_to\" or \"tx_logs_topic0\".",
+			Optional:    true,
+		},
+		"operator": schema.StringAttribute{
+			Description: "The comparison operator, defaults to '=='. ENUM: '==', '!=', '>', '>=', '<', '<='",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("==", "!=", ">", ">=", "<", "<="),
+			},
+		},
+		"value": schema.StringAttribute{
+			Description: "The value to compare the field with. It is quoted as needed.",
+			Optional:    true,
+		},
+	}
+}
+
+func conditionBlocks(depth int) map[string]schema.Block {
+	if depth == 0 {
+		return nil
+	}
+
+	nested := schema.NestedBlockObject{
+		Attributes: conditionAttributes(),
+		Blocks:     conditionBlocks(depth - 1),
+	}
+	return map[string]schema.Block{
+		"all": schema.ListNestedBlock{
+			Description:  "Conditions that must all match.",
+			NestedObject: nested,
+		},
+		"any": schema.ListNestedBlock{
+			Description:  "Conditions of which at least one must match.",
+			NestedObject: nested,
+		},
+	}
+}
+
+// compileCondition compiles a condition block into an expression. known is
+// false when the condition holds values that are not known yet.
+func compileCondition(condition types.Object, p path.Path) (node expression.Node, known bool, diags diag.Diagnostics) {
+	if condition.IsUnknown() {
+		return nil, false, nil
+	}
+
+	attributes := condition.Attributes()
+	field, _ := attributes["field"].(types.String)
+	operator, _ := attributes["operator"].(types.String)
+	value, _ := attributes["value"].(types.String)
+	all, _ := attributes["all"].(types.List)
+	anyOf, _ := attributes["any"].(types.List)
+
+	set := 0
+	for _, isSet := range []bool{
+		!field.IsNull(),
+		!all.IsNull() && (all.IsUnknown() || len(all.Elements()) > 0),
+		!anyOf.IsNull() && (anyOf.IsUnknown() || len(anyOf.Elements()) > 0),
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		diags.AddAttributeError(p, "Invalid Condition", "A condition must set exactly one of field, all or any.")
+		return nil, false, diags
+	}
+
+	switch {
+	case !field.IsNull():
+		if value.IsNull() {
+			diags.AddAttributeError(p.AtName("value"), "Invalid Condition", "value is required when field is set.")
+			return nil, false, diags
+		}
+		if field.IsUnknown() || operator.IsUnknown() || value.IsUnknown() {
+			return nil, false, diags
+		}
+
+		if !expression.IsField(field.ValueString()) {
+			diags.AddAttributeError(p.AtName("field"), "Invalid Condition", fmt.Sprintf("Unknown field %q.", field.ValueString()))
+			return nil, false, diags
+		}
+
+		op := "=="
+		if !operator.IsNull() {
+			op = operator.ValueString()
+		}
+		node = expression.Compare(field.ValueString(), op, value.ValueString())
+
+		// the parser knows which fields can be ordered and which values must be quoted
+		if _, err := expression.Parse(node.String()); err != nil {
+			message := err.Error()
+			var syntaxErr *expression.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				message = syntaxErr.Message
+			}
+			diags.AddAttributeError(p, "Invalid Condition", fmt.Sprintf("%s compiles to an invalid expression: %s.", node, message))
+			return nil, false, diags
+		}
+		return node, true, diags
+	case !all.IsNull() && len(all.Elements()) > 0:
+		return compileConditions(all, p.AtName("all"), expression.And)
+	default:
+		return compileConditions(anyOf, p.AtName("any"), expression.Or)
+	}
+}
+
+// compileConditions compiles a list of condition blocks and joins them.
+func compileConditions(conditions types.List, p path.Path, join func(...expression.Node) expression.Node) (expression.Node, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if conditions.IsUnknown() {
+		return nil, false, diags
+	}
+
+	known := true
+	var nodes []expression.Node
+	for i, element := range conditions.Elements() {
+		condition, ok := element.(types.Object)
+		if !ok {
+			diags.AddAttributeError(p.AtListIndex(i), "Invalid Condition", fmt.Sprintf("Unexpected condition type %T. Please report this issue to the provider developers.", element))
+			continue
+		}
+
+		node, nodeKnown, nodeDiags := compileCondition(condition, p.AtListIndex(i))
+		diags.Append(nodeDiags...)
+		known = known && nodeKnown
+		nodes = append(nodes, node)
+	}
+	if diags.HasError() || !known {
+		return nil, false, diags
+	}
+	return join(nodes...), true, diags
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testCondition describes a condition block for tests.
+type testCondition struct {
+	field, operator, value string
+	all, any               []testCondition
+}
+
+// terraformValue converts the condition to a Terraform value of type objectType.
+func (c testCondition) terraformValue(objectType tftypes.Object) tftypes.Value {
+	stringValue := func(s string) tftypes.Value {
+		if s == "" {
+			return tftypes.NewValue(tftypes.String, nil)
+		}
+		return tftypes.NewValue(tftypes.String, s)
+	}
+	listValue := func(name string, conditions []testCondition) tftypes.Value {
+		listType := objectType.AttributeTypes[name].(tftypes.List)
+		elements := []tftypes.Value{}
+		for _, condition := range conditions {
+			elements = append(elements, condition.terraformValue(listType.ElementType.(tftypes.Object)))
+		}
+		return tftypes.NewValue(listType, elements)
+	}
+
+	attributes := map[string]tftypes.Value{
+		"field":    stringValue(c.field),
+		"operator": stringValue(c.operator),
+		"value":    stringValue(c.value),
+	}
+	if _, ok := objectType.AttributeTypes["all"]; ok {
+		attributes["all"] = listValue("all", c.all)
+		attributes["any"] = listValue("any", c.any)
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func TestCompileCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition testCondition
+		expected  string
+		error     string
+	}{
+		{
+			name:      "single comparison",
+			condition: testCondition{field: "tx_to", value: "0x1"},
+			expected:  "tx_to == '0x1'",
+		},
+		{
+			name: "erc20 transfer to any wallet over a value",
+			condition: testCondition{all: []testCondition{
+				{field: "tx_logs_topic0", value: "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+				{any: []testCondition{
+					{field: "tx_logs_topic2", value: "0x1"},
+					{field: "tx_logs_topic2", value: "0x2"},
+				}},
+				{field: "tx_value", operator: ">", value: "1000"},
+			}},
+			expected: "tx_logs_topic0 == '0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef' && " +
+				"(tx_logs_topic2 == '0x1' || tx_logs_topic2 == '0x2') && tx_value > 1000",
+		},
+		{
+			name:      "nothing set",
+			condition: testCondition{},
+			error:     "exactly one of field, all or any",
+		},
+		{
+			name:      "field and all set",
+			condition: testCondition{field: "tx_to", value: "0x1", all: []testCondition{{field: "tx_from", value: "0x1"}}},
+			error:     "exactly one of field, all or any",
+		},
+		{
+			name:      "missing value",
+			condition: testCondition{any: []testCondition{{field: "tx_to"}}},
+			error:     "value is required",
+		},
+		{
+			name:      "unknown field",
+			condition: testCondition{field: "tx_too", value: "0x1"},
+			error:     `Unknown field "tx_too"`,
+		},
+		{
+			name:      "ordering a string field",
+			condition: testCondition{field: "tx_to", operator: ">", value: "0x1"},
+			error:     "can only be compared",
+		},
+	}
+
+	blockType := conditionBlock().Type().TerraformType(context.Background()).(tftypes.Object)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := conditionBlock().Type().ValueFromTerraform(context.Background(), tt.condition.terraformValue(blockType))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			node, known, diags := compileCondition(value.(types.Object), path.Root("condition"))
+			if tt.error != "" {
+				if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.error) {
+					t.Fatalf("expected an error mentioning %q, got %v", tt.error, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !known {
+				t.Fatal("compiled condition is unknown")
+			}
+			if node.String() != tt.expected {
+				t.Errorf("compiled %s, want %s", node, tt.expected)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jmtx1020/go_quicknode/api/notifications"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
 )
 
 var (
@@ -18,14 +20,16 @@ var (
 )
 
 type notificationDataResourceModel struct {
-	ID           types.String               `tfsdk:"id"`
-	Name         types.String               `tfsdk:"name"`
-	Expression   types.String               `tfsdk:"expression"`
-	Network      types.String               `tfsdk:"network"`
-	Enabled      types.Bool                 `tfsdk:"enabled"`
-	Destinations []destinationResourceModel `tfsdk:"destinations"`
-	CreatedAt    types.String               `tfsdk:"created_at"`
-	UpdatedAt    types.String               `tfsdk:"updated_at"`
+	ID            types.String       `tfsdk:"id"`
+	Name          types.String       `tfsdk:"name"`
+	Expression    types.String       `tfsdk:"expression"`
+	ExpressionRaw types.String       `tfsdk:"expression_raw"`
+	Network       types.String       `tfsdk:"network"`
+	Enabled       types.Bool         `tfsdk:"enabled"`
+	Destinations  []destinationModel `tfsdk:"destinations"`
+	Labels        map[string]string  `tfsdk:"labels"`
+	CreatedAt     timestampValue     `tfsdk:"created_at"`
+	UpdatedAt     timestampValue     `tfsdk:"updated_at"`
 }
 
 func NewNotificationDataSource() datasource.DataSource {
@@ -52,22 +56,33 @@ func (n *notificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:    true,
 			},
 			"expression": schema.StringAttribute{
-				Description: "The expression for the notification.",
+				Description: "The expression for the notification as plain text. Prefer expression_raw, which matches the attribute of the quicknode_notification resource.",
+				Computed:    true,
+			},
+			"expression_raw": schema.StringAttribute{
+				Description: "The expression for the notification as plain text.",
 				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the notification.",
 				Computed:    true,
 			},
+			"labels": schema.MapAttribute{
+				Description: "The labels encoded at the end of the name of the notification.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"network": schema.StringAttribute{
 				Description: "The webhook URL to which QuickAlerts will send alert payloads.",
 				Computed:    true,
 			},
 			"created_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was created.",
 				Computed:    true,
 			},
 			"updated_at": schema.StringAttribute{
+				CustomType:  timestampType{},
 				Description: "The date and time the destination was last updated.",
 				Computed:    true,
 			},
@@ -104,11 +119,18 @@ func (n *notificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							Description: "The type of payload to send. ENUM: 1,2,3,4,5,6,7",
 							Computed:    true,
 						},
+						"labels": schema.MapAttribute{
+							Description: "The labels encoded at the end of the name of the destination.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 						"created_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was created.",
 							Computed:    true,
 						},
 						"updated_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was last updated.",
 							Computed:    true,
 						},
@@ -123,7 +145,7 @@ func (n *notificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	var state notificationDataResourceModel
 
 	req.Config.GetAttribute(ctx, path.Root("id"), &state.ID)
-	notificationsAPI := &notifications.NotificationAPI{API: n.client}
+	notificationsAPI := &notifications.NotificationAPI{API: api.WithContext(ctx, n.client)}
 
 	notif, err := notificationsAPI.GetNotificationByID(state.ID.ValueString())
 	if err != nil {
@@ -132,30 +154,35 @@ func (n *notificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 			err.Error())
 	}
 
-	var destinationModels []destinationResourceModel
+	var destinationModels []destinationModel
 	for _, dest := range notif.Destinations {
-		destModel := destinationResourceModel{
+		name, labels := decodeLabels(dest.Name)
+		destModel := destinationModel{
 			ID:          types.StringValue(dest.ID),
-			Name:        types.StringValue(dest.Name),
+			Name:        types.StringValue(name),
+			Labels:      labels,
 			To:          types.StringValue(dest.To),
 			WebhookType: types.StringValue(dest.WebhookType),
 			Service:     types.StringValue(dest.Service),
 			Token:       types.StringValue(dest.Token),
 			PayloadType: types.Int64Value(int64(dest.PayloadType)),
-			CreatedAt:   types.StringValue(dest.CreatedAt.Format("2006-01-02 15:04:05")),
-			UpdatedAt:   types.StringValue(dest.UpdatedAt.Format("2006-01-02 15:04:05")),
+			CreatedAt:   newTimestampValue(dest.CreatedAt),
+			UpdatedAt:   newTimestampValue(dest.UpdatedAt),
 		}
 		destinationModels = append(destinationModels, destModel)
 	}
 
 	state.ID = types.StringValue(notif.ID)
-	state.Name = types.StringValue(notif.Name)
+	name, labels := decodeLabels(notif.Name)
+	state.Name = types.StringValue(name)
+	state.Labels = labels
 	state.Network = types.StringValue(notif.Network)
 	state.Expression = types.StringValue(notif.Expression)
+	state.ExpressionRaw = types.StringValue(notif.Expression)
 	state.Enabled = types.BoolValue(notif.Enabled)
 	state.Destinations = destinationModels
-	state.CreatedAt = types.StringValue(notif.CreatedAt.Format("2006-01-02 15:04:05"))
-	state.UpdatedAt = types.StringValue(notif.UpdatedAt.Format("2006-01-02 15:04:05"))
+	state.CreatedAt = newTimestampValue(notif.CreatedAt)
+	state.UpdatedAt = newTimestampValue(notif.UpdatedAt)
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
@@ -170,14 +197,14 @@ func (n *notificationDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	n.client = apiWrapper
+	n.client = data.client
 }
@@ -2,26 +2,43 @@ package provider
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jmtx1020/go_quicknode/api/notifications"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/quickalerts"
+	"terraform-provider-quicknode/internal/expression"
 )
 
 var (
-	_ resource.Resource                = &notificationResource{}
-	_ resource.ResourceWithConfigure   = &notificationResource{}
-	_ resource.ResourceWithImportState = &notificationResource{}
+	_ resource.Resource                     = &notificationResource{}
+	_ resource.ResourceWithConfigure        = &notificationResource{}
+	_ resource.ResourceWithImportState      = &notificationResource{}
+	_ resource.ResourceWithConfigValidators = &notificationResource{}
+	_ resource.ResourceWithModifyPlan       = &notificationResource{}
+	_ resource.ResourceWithValidateConfig   = &notificationResource{}
+	_ resource.ResourceWithUpgradeState     = &notificationResource{}
 )
 
 type notificationResource struct {
-	client *client.APIWrapper
+	client        *client.APIWrapper
+	defaultLabels map[string]string
 }
 
 func NewNotificationResource() resource.Resource {
@@ -29,14 +46,50 @@ func NewNotificationResource() resource.Resource {
 }
 
 type notificationResourceModel struct {
-	ID             types.String   `tfsdk:"id"`
-	Name           types.String   `tfsdk:"name"`
-	Expression     types.String   `tfsdk:"expression"`
-	Network        types.String   `tfsdk:"network"`
-	Enabled        types.Bool     `tfsdk:"enabled"`
-	DestinationIDs []types.String `tfsdk:"destination_ids"`
-	CreatedAt      types.String   `tfsdk:"created_at"`
-	UpdatedAt      types.String   `tfsdk:"updated_at"`
+	ID             types.String          `tfsdk:"id"`
+	Name           types.String          `tfsdk:"name"`
+	Expression     base64ExpressionValue `tfsdk:"expression"`
+	ExpressionRaw  types.String          `tfsdk:"expression_raw"`
+	Condition      types.Object          `tfsdk:"condition"`
+	Network        types.String          `tfsdk:"network"`
+	Enabled        types.Bool            `tfsdk:"enabled"`
+	DestinationIDs []types.String        `tfsdk:"destination_ids"`
+	CreatedAt      timestampValue        `tfsdk:"created_at"`
+	UpdatedAt      timestampValue        `tfsdk:"updated_at"`
+	Labels         types.Map             `tfsdk:"labels"`
+	LabelsAll      types.Map             `tfsdk:"labels_all"`
+	Timeouts       timeouts.Value        `tfsdk:"timeouts"`
+}
+
+// fillExpressions compiles the condition into expression and expression_raw,
+// or derives whichever of them is not known yet from the other one.
+func (m *notificationResourceModel) fillExpressions() diag.Diagnostics {
+	if !m.Condition.IsNull() {
+		node, known, diags := compileCondition(m.Condition, path.Root("condition"))
+		if known {
+			m.ExpressionRaw = types.StringValue(node.String())
+			m.Expression = newBase64ExpressionValue(encodeExpression(node.String()))
+		}
+		return diags
+	}
+
+	var diags diag.Diagnostics
+
+	rawKnown := !m.ExpressionRaw.IsNull() && !m.ExpressionRaw.IsUnknown()
+	encodedKnown := !m.Expression.IsNull() && !m.Expression.IsUnknown()
+
+	switch {
+	case rawKnown && !encodedKnown:
+		m.Expression = newBase64ExpressionValue(encodeExpression(m.ExpressionRaw.ValueString()))
+	case encodedKnown && !rawKnown:
+		expression, err := decodeExpression(m.Expression.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("expression"), "Invalid Base64 Expression", err.Error())
+			return diags
+		}
+		m.ExpressionRaw = types.StringValue(expression)
+	}
+	return diags
 }
 
 // Configure adds the provider configured client to the resource.
@@ -45,16 +98,17 @@ func (n *notificationResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	n.client = apiWrapper
+	n.client = data.client
+	n.defaultLabels = data.defaultLabels
 }
 
 // Metadata returns the resource type name.
@@ -62,16 +116,27 @@ func (n *notificationResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_notification"
 }
 
-func (n *notificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (n *notificationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The notification ID.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"expression": schema.StringAttribute{
-				Description: "The expression for the notification.",
-				Required:    true,
+				Description: "The base64 encoded expression for the notification. Exactly one of expression, expression_raw or condition must be set.",
+				CustomType:  base64ExpressionType{},
+				Optional:    true,
+				Computed:    true,
+			},
+			"expression_raw": schema.StringAttribute{
+				Description: "The expression for the notification as plain text, e.g. \"tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'\". The provider takes care of the base64 encoding and checks the syntax at plan time. Holds the compiled expression when condition is set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"enabled": schema.BoolAttribute{
 				Description: "Whether the notification is enabled.",
@@ -81,26 +146,128 @@ func (n *notificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "The name of the notification.",
 				Required:    true,
 			},
+			"labels":     labelsAttribute("name"),
+			"labels_all": labelsAllAttribute(),
 			"network": schema.StringAttribute{
-				Description: "The webhook URL to which QuickAlerts will send alert payloads.",
-				Required:    true,
+				Description: "The network the notification monitors, e.g. \"ethereum-mainnet\". " +
+					"The quickalerts_network attribute of the quicknode_chains data source lists the networks.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(quickAlertsNetworkRegexp, "must be a QuickAlerts network such as \"ethereum-mainnet\""),
+				},
 			},
 			"created_at": schema.StringAttribute{
-				Description: "The date and time the destination was created.",
+				CustomType:  timestampType{},
+				Description: "The date and time the notification was created.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
-				Description: "The date and time the destination was last updated.",
+				CustomType:  timestampType{},
+				Description: "The date and time the notification was last updated.",
 				Computed:    true,
 			},
-			"destination_ids": schema.ListAttribute{
+			"destination_ids": schema.SetAttribute{
+				Description: "The IDs of the destinations the notification is sent to. The order is not significant.",
 				Optional:    true,
 				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts":  timeouts.BlockAll(ctx),
+			"condition": conditionBlock(),
+		},
+	}
+}
+
+// ConfigValidators ensures the expression is given exactly once.
+func (n *notificationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("expression"),
+			path.MatchRoot("expression_raw"),
+			path.MatchRoot("condition"),
+		),
+	}
+}
+
+// ValidateConfig checks the expression syntax so typos are reported before any API call.
+func (n *notificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config notificationResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Condition.IsNull() {
+		_, _, diags = compileCondition(config.Condition, path.Root("condition"))
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	attribute := path.Root("expression_raw")
+	input := config.ExpressionRaw.ValueString()
+	switch {
+	case config.ExpressionRaw.IsUnknown() || config.Expression.IsUnknown():
+		return
+	case config.ExpressionRaw.IsNull() && config.Expression.IsNull():
+		return
+	case config.ExpressionRaw.IsNull():
+		attribute = path.Root("expression")
+		decoded, err := decodeExpression(config.Expression.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(attribute, "Invalid Base64 Expression", err.Error())
+			return
+		}
+		input = decoded
+	}
+
+	err := expression.Validate(input)
+	var syntaxErr *expression.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		resp.Diagnostics.AddAttributeError(
+			attribute,
+			"Invalid QuickAlerts Expression",
+			fmt.Sprintf("The expression is invalid at column %d: %s\n\n%s", syntaxErr.Column, syntaxErr.Message, expression.Caret(input, syntaxErr.Column)),
+		)
 	}
 }
 
+// ModifyPlan keeps expression and expression_raw in sync and merges the
+// default labels.
+func (n *notificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan notificationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fillExpressions()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyPlanLabels(ctx, n.defaultLabels, req, resp)
+}
+
 func (n *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan notificationResourceModel
 
@@ -110,16 +277,36 @@ func (n *notificationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(plan.fillExpressions()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert []types.String to []string
 	destinationIds := make([]string, len(plan.DestinationIDs))
 	for i, dest := range plan.DestinationIDs {
 		destinationIds[i] = dest.ValueString()
 	}
 
-	notificationsAPI := &notifications.NotificationAPI{API: n.client}
+	name, diags := encodeLabelsValue(ctx, plan.Name.ValueString(), plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationsAPI := &notifications.NotificationAPI{API: api.WithContext(ctx, n.client)}
 	notification, err := notificationsAPI.CreateNotification(
-		plan.Name.ValueString(),
-		plan.Expression.ValueString(),
+		name,
+		encodeExpression(plan.ExpressionRaw.ValueString()),
 		plan.Network.ValueString(),
 		destinationIds,
 	)
@@ -161,8 +348,8 @@ func (n *notificationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	plan.ID = types.StringValue(notification.ID)
-	plan.CreatedAt = types.StringValue(notification.CreatedAt.Format("2006-01-02 15:04:05"))
-	plan.UpdatedAt = types.StringValue(notification.UpdatedAt.Format("2006-01-02 15:04:05"))
+	plan.CreatedAt = newTimestampValue(notification.CreatedAt)
+	plan.UpdatedAt = newTimestampValue(notification.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -179,9 +366,23 @@ func (n *notificationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	notificationsAPI := &notifications.NotificationAPI{API: n.client}
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationsAPI := &quickalerts.NotificationAPI{API: api.WithContext(ctx, n.client)}
 	notif, err := notificationsAPI.GetNotificationByID(state.ID.ValueString())
 	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading QuickNode Notification",
 			"Could not read QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
@@ -194,15 +395,18 @@ func (n *notificationResource) Read(ctx context.Context, req resource.ReadReques
 		destinationIds[i] = types.StringValue(dest.ID)
 	}
 
-	notif_bytes := []byte(notif.Expression)
-	notif_expr_b64 := base64.StdEncoding.EncodeToString(notif_bytes)
-
+	// expressions that only differ in their encoding are kept as configured
+	name, labels := decodeLabels(notif.Name)
 	state.ID = types.StringValue(notif.ID)
-	state.Name = types.StringValue(notif.Name)
+	state.Name = types.StringValue(name)
+	readLabels(n.defaultLabels, labels, &state.Labels, &state.LabelsAll)
 	state.Enabled = types.BoolValue(notif.Enabled)
-	state.Expression = types.StringValue(notif_expr_b64)
+	state.Expression = newBase64ExpressionValue(encodeExpression(notif.Expression))
+	state.ExpressionRaw = types.StringValue(notif.Expression)
 	state.Network = types.StringValue(notif.Network)
 	state.DestinationIDs = destinationIds
+	state.CreatedAt = newTimestampValue(notif.CreatedAt)
+	state.UpdatedAt = newTimestampValue(notif.UpdatedAt)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -220,6 +424,15 @@ func (n *notificationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var state notificationResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -227,16 +440,27 @@ func (n *notificationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resp.Diagnostics.Append(plan.fillExpressions()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	destinationIDs := make([]string, len(plan.DestinationIDs))
 	for i, id := range plan.DestinationIDs {
 		destinationIDs[i] = id.ValueString()
 	}
 
-	notificationsAPI := &notifications.NotificationAPI{API: n.client}
+	name, diags := encodeLabelsValue(ctx, plan.Name.ValueString(), plan.LabelsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationsAPI := &notifications.NotificationAPI{API: api.WithContext(ctx, n.client)}
 	notif, err := notificationsAPI.UpdateNotificationByID(
 		state.ID.ValueString(),
-		plan.Name.ValueString(),
-		plan.Expression.ValueString(),
+		name,
+		encodeExpression(plan.ExpressionRaw.ValueString()),
 		destinationIDs,
 	)
 	if err != nil {
@@ -276,17 +500,13 @@ func (n *notificationResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 	}
 
-	notif_bytes := []byte(notif.Expression)
-	notif_expr_b64 := base64.StdEncoding.EncodeToString(notif_bytes)
-
-	tflog.Debug(ctx, "THIS IS ORIGINAL PLAN DEBUG: "+plan.Expression.ValueString())
-	tflog.Debug(ctx, "THIS IS FROM API PLAN DEBUG: "+notif.Expression)
-	tflog.Debug(ctx, "THIS IS FROM API CONVERTED TO BASE64"+notif_expr_b64)
+	tflog.Debug(ctx, "Updated notification expression", map[string]any{"expression": notif.Expression})
 
+	name, _ = decodeLabels(notif.Name)
 	plan.ID = types.StringValue(notif.ID)
-	plan.Name = types.StringValue(notif.Name)
-	plan.CreatedAt = types.StringValue(notif.CreatedAt.Format("2006-01-02 15:04:05"))
-	plan.UpdatedAt = types.StringValue(notif.UpdatedAt.Format("2006-01-02 15:04:05"))
+	plan.Name = types.StringValue(name)
+	plan.CreatedAt = newTimestampValue(notif.CreatedAt)
+	plan.UpdatedAt = newTimestampValue(notif.UpdatedAt)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -303,9 +523,18 @@ func (n *notificationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	notificationsAPI := &notifications.NotificationAPI{API: n.client}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	notificationsAPI := &quickalerts.NotificationAPI{API: api.WithContext(ctx, n.client)}
 	err := notificationsAPI.DeleteNotificationByID(state.ID.ValueString())
-	if err != nil {
+	if err != nil && !api.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting QuickNode Notification"+state.ID.ValueString(),
 			"Could not delete destination, unexpected error: "+err.Error(),
@@ -314,6 +543,11 @@ func (n *notificationResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+// UpgradeState converts the timestamps of version 0 state to RFC3339.
+func (n *notificationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return timestampStateUpgraders("created_at", "updated_at")
+}
+
 func (n *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -29,12 +34,194 @@ func TestNotificationResource(t *testing.T) {
 				`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("quicknode_notification.test", "id"),
-					resource.TestCheckResourceAttrSet("quicknode_notification.test", "destination_ids.0"),
+					resource.TestCheckResourceAttr("quicknode_notification.test", "destination_ids.#", "1"),
 					resource.TestCheckResourceAttr("quicknode_notification.test", "name", "test_notification"),
 					resource.TestCheckResourceAttr("quicknode_notification.test", "network", "ethereum-mainnet"),
 					resource.TestCheckResourceAttr("quicknode_notification.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("quicknode_notification.test", "expression_raw", "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'"),
 				),
 			},
+			// The same expression as plain text is not a change
+			{
+				Config: providerConfig + `
+				resource "quicknode_destination" "test" {
+		  			name         = "au-test-api"
+					to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+					webhook_type = "POST"
+					service      = "webhook"
+					payload_type = 1
+				}
+				resource "quicknode_notification" "test" {
+					name            = "test_notification"
+					network         = "ethereum-mainnet"
+					expression_raw  = "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'"
+					destination_ids = [resource.quicknode_destination.test.id]
+					enabled         = true
+				}
+				`,
+				PlanOnly: true,
+			},
+			// Update testing with a condition block
+			{
+				Config: providerConfig + `
+				resource "quicknode_destination" "test" {
+		  			name         = "au-test-api"
+					to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+					webhook_type = "POST"
+					service      = "webhook"
+					payload_type = 1
+				}
+				resource "quicknode_notification" "test" {
+					name            = "test_notification"
+					network         = "ethereum-mainnet"
+					destination_ids = [resource.quicknode_destination.test.id]
+					enabled         = true
+
+					condition {
+						any {
+							field = "tx_to"
+							value = "0xd8da6bf26964af9d7eed9e03e53415d37aa96045"
+						}
+						any {
+							field = "tx_from"
+							value = "0xd8da6bf26964af9d7eed9e03e53415d37aa96045"
+						}
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_notification.test", "expression_raw", "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045' || tx_from == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'"),
+				),
+			},
+			// Update testing with several destinations
+			{
+				Config: providerConfig + `
+				resource "quicknode_destination" "test" {
+		  			name         = "au-test-api"
+					to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+					webhook_type = "POST"
+					service      = "webhook"
+					payload_type = 1
+				}
+				resource "quicknode_destination" "second" {
+					name         = "au-test-api-second"
+					to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-2"
+					webhook_type = "POST"
+					service      = "webhook"
+					payload_type = 1
+				}
+				resource "quicknode_notification" "test" {
+					name            = "test_notification"
+					network         = "ethereum-mainnet"
+					expression_raw  = "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'"
+					destination_ids = [resource.quicknode_destination.test.id, resource.quicknode_destination.second.id]
+					enabled         = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_notification.test", "destination_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("quicknode_notification.test", "destination_ids.*", "quicknode_destination.second", "id"),
+				),
+			},
+			// The order of the destinations is not a change
+			{
+				Config: providerConfig + `
+				resource "quicknode_destination" "test" {
+		  			name         = "au-test-api"
+					to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+					webhook_type = "POST"
+					service      = "webhook"
+					payload_type = 1
+				}
+				resource "quicknode_destination" "second" {
+					name         = "au-test-api-second"
+					to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-2"
+					webhook_type = "POST"
+					service      = "webhook"
+					payload_type = 1
+				}
+				resource "quicknode_notification" "test" {
+					name            = "test_notification"
+					network         = "ethereum-mainnet"
+					expression_raw  = "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'"
+					destination_ids = [resource.quicknode_destination.second.id, resource.quicknode_destination.test.id]
+					enabled         = true
+				}
+				`,
+				PlanOnly: true,
+			},
 		},
 	})
 }
+
+// testNotificationConfig builds a quicknode_notification configuration with
+// the given attribute values, leaving all others null.
+func testNotificationConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	NewNotificationResource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
+
+func TestNotificationResourceValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute string
+		value     string
+		error     string
+	}{
+		{"valid raw expression", "expression_raw", "tx_to == '0xd8da6bf26964af9d7eed9e03e53415d37aa96045'", ""},
+		{"valid base64 expression", "expression", encodeExpression("tx_value > 0"), ""},
+		{"unquoted hex value", "expression_raw", "tx_to == 0xabc", "column 10"},
+		{"unknown field", "expression_raw", "tx_to == '0x1' && tx_too == '0x2'", "column 19"},
+		{"invalid base64 expression", "expression", encodeExpression("tx_to = '0x1'"), "column 7"},
+		{"not base64", "expression", "tx_to == '0x1'", "Invalid Base64 Expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ValidateConfigRequest{
+				Config: testNotificationConfig(t, map[string]tftypes.Value{
+					tt.attribute: tftypes.NewValue(tftypes.String, tt.value),
+				}),
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+			NewNotificationResource().(fwresource.ResourceWithValidateConfig).ValidateConfig(context.Background(), req, resp)
+
+			if tt.error == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected an error mentioning %q", tt.error)
+			}
+			diagnostic := resp.Diagnostics.Errors()[0]
+			if !strings.Contains(diagnostic.Summary()+diagnostic.Detail(), tt.error) {
+				t.Errorf("error %q: %q does not mention %q", diagnostic.Summary(), diagnostic.Detail(), tt.error)
+			}
+		})
+	}
+}
+
+func TestNotificationResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewNotificationResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "notif-1"),
+	})
+}
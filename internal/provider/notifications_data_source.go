@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	notifications "github.com/jmtx1020/go_quicknode/api/notifications"
 	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
 )
 
 var (
@@ -25,18 +27,21 @@ func NewNotificationsDataSource() datasource.DataSource {
 }
 
 type notificationsDataSourceModel struct {
+	Labels        map[string]string    `tfsdk:"labels"`
 	Notifications []notificationsModel `tfsdk:"notifications"`
 }
 
 type notificationsModel struct {
-	ID           types.String       `tfsdk:"id"`
-	Name         types.String       `tfsdk:"name"`
-	Expression   types.String       `tfsdk:"expression"`
-	Network      types.String       `tfsdk:"network"`
-	Enabled      types.Bool         `tfsdk:"enabled"`
-	Destinations []destinationModel `tfsdk:"destinations"`
-	CreatedAt    types.String       `tfsdk:"created_at"`
-	UpdatedAt    types.String       `tfsdk:"updated_at"`
+	ID            types.String       `tfsdk:"id"`
+	Name          types.String       `tfsdk:"name"`
+	Expression    types.String       `tfsdk:"expression"`
+	ExpressionRaw types.String       `tfsdk:"expression_raw"`
+	Network       types.String       `tfsdk:"network"`
+	Enabled       types.Bool         `tfsdk:"enabled"`
+	Destinations  []destinationModel `tfsdk:"destinations"`
+	Labels        map[string]string  `tfsdk:"labels"`
+	CreatedAt     timestampValue     `tfsdk:"created_at"`
+	UpdatedAt     timestampValue     `tfsdk:"updated_at"`
 }
 
 func (n *notificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +51,11 @@ func (n *notificationsDataSource) Metadata(_ context.Context, req datasource.Met
 func (d *notificationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"labels": schema.MapAttribute{
+				Description: "Only return the notifications that have all of these labels.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"notifications": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -55,9 +65,13 @@ func (d *notificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Computed:    true,
 						},
 						"expression": schema.StringAttribute{
-							Description: "The expression for the notification.",
+							Description: "The expression for the notification as plain text. Prefer expression_raw, which matches the attribute of the quicknode_notification resource.",
 							Required:    true,
 						},
+						"expression_raw": schema.StringAttribute{
+							Description: "The expression for the notification as plain text.",
+							Computed:    true,
+						},
 						"enabled": schema.BoolAttribute{
 							Description: "Whether the notification is enabled.",
 							Required:    true,
@@ -66,15 +80,22 @@ func (d *notificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Description: "The name of the notification.",
 							Required:    true,
 						},
+						"labels": schema.MapAttribute{
+							Description: "The labels encoded at the end of the name of the notification.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 						"network": schema.StringAttribute{
 							Description: "The webhook URL to which QuickAlerts will send alert payloads.",
 							Required:    true,
 						},
 						"created_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was created.",
 							Computed:    true,
 						},
 						"updated_at": schema.StringAttribute{
+							CustomType:  timestampType{},
 							Description: "The date and time the destination was last updated.",
 							Computed:    true,
 						},
@@ -111,11 +132,18 @@ func (d *notificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 										Description: "The type of payload to send. ENUM: 1,2,3,4,5,6,7",
 										Computed:    true,
 									},
+									"labels": schema.MapAttribute{
+										Description: "The labels encoded at the end of the name of the destination.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
 									"created_at": schema.StringAttribute{
+										CustomType:  timestampType{},
 										Description: "The date and time the destination was created.",
 										Computed:    true,
 									},
 									"updated_at": schema.StringAttribute{
+										CustomType:  timestampType{},
 										Description: "The date and time the destination was last updated.",
 										Computed:    true,
 									},
@@ -134,22 +162,27 @@ func (n *notificationsDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	apiWrapper, ok := req.ProviderData.(*client.APIWrapper)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.APIWrapper, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	n.client = apiWrapper
+	n.client = data.client
 }
 
 func (n *notificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state notificationsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	notificationsAPI := &notifications.NotificationAPI{API: n.client}
+	notificationsAPI := &notifications.NotificationAPI{API: api.WithContext(ctx, n.client)}
 	notifications, err := notificationsAPI.GetAllNotifications()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -158,27 +191,36 @@ func (n *notificationsDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	for _, notification := range notifications {
+		name, labels := decodeLabels(notification.Name)
+		if !matchLabels(labels, state.Labels) {
+			continue
+		}
+
 		notificationState := notificationsModel{
-			ID:         types.StringValue(notification.ID),
-			Name:       types.StringValue(notification.Name),
-			Expression: types.StringValue(notification.Expression),
-			Network:    types.StringValue(notification.Network),
-			Enabled:    types.BoolValue(notification.Enabled),
-			CreatedAt:  types.StringValue(notification.CreatedAt.Format("2006-01-02 15:04:05")),
-			UpdatedAt:  types.StringValue(notification.UpdatedAt.Format("2006-01-02 15:04:05")),
+			ID:            types.StringValue(notification.ID),
+			Name:          types.StringValue(name),
+			Labels:        labels,
+			Expression:    types.StringValue(notification.Expression),
+			ExpressionRaw: types.StringValue(notification.Expression),
+			Network:       types.StringValue(notification.Network),
+			Enabled:       types.BoolValue(notification.Enabled),
+			CreatedAt:     newTimestampValue(notification.CreatedAt),
+			UpdatedAt:     newTimestampValue(notification.UpdatedAt),
 		}
 
 		for _, dest := range notification.Destinations {
+			name, labels := decodeLabels(dest.Name)
 			destinationState := destinationModel{
 				ID:          types.StringValue(dest.ID),
-				Name:        types.StringValue(dest.Name),
+				Name:        types.StringValue(name),
+				Labels:      labels,
 				To:          types.StringValue(dest.To),
 				WebhookType: types.StringValue(dest.WebhookType),
 				Service:     types.StringValue(dest.Service),
 				Token:       types.StringValue(dest.Token),
 				PayloadType: types.Int64Value(int64(dest.PayloadType)),
-				CreatedAt:   types.StringValue(dest.CreatedAt.Format("2006-01-02 15:04:05")),
-				UpdatedAt:   types.StringValue(dest.UpdatedAt.Format("2006-01-02 15:04:05")),
+				CreatedAt:   newTimestampValue(dest.CreatedAt),
+				UpdatedAt:   newTimestampValue(dest.UpdatedAt),
 			}
 
 			notificationState.Destinations = append(notificationState.Destinations, destinationState)
@@ -188,7 +230,7 @@ func (n *notificationsDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
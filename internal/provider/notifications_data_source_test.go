@@ -39,6 +39,36 @@ func TestNotificationsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.quicknode_notifications.test", "notifications.0.created_at"),
 				),
 			},
+			// label filter testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_destination" "test" {
+		  			name         = "au-test-api"
+					to           = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+					webhook_type = "POST"
+					service      = "webhook"
+					payload_type = 1
+				}
+				resource "quicknode_notification" "test" {
+					name            = "test_notification"
+					network         = "ethereum-mainnet"
+					expression      = "dHhfdG8gPT0gJzB4ZDhkYTZiZjI2OTY0YWY5ZDdlZWQ5ZTAzZTUzNDE1ZDM3YWE5NjA0Nic="
+					destination_ids = [resource.quicknode_destination.test.id]
+					enabled         = true
+					labels          = {
+						team = "tf-acc-test-notifications"
+					}
+				}
+				data "quicknode_notifications" "test" {
+					labels = quicknode_notification.test.labels
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quicknode_notifications.test", "notifications.#", "1"),
+					resource.TestCheckResourceAttr("data.quicknode_notifications.test", "notifications.0.name", "test_notification"),
+					resource.TestCheckResourceAttr("data.quicknode_notifications.test", "notifications.0.labels.team", "tf-acc-test-notifications"),
+				),
+			},
 		},
 	})
 }
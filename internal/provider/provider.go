@@ -3,15 +3,22 @@ package provider
 import (
 	"context"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jmtx1020/go_quicknode/client"
+	"golang.org/x/time/rate"
+
+	"terraform-provider-quicknode/internal/api"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -37,10 +44,37 @@ type quicknodeProvider struct {
 }
 
 type quicknodeProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host              types.String  `tfsdk:"host"`
+	Token             types.String  `tfsdk:"token"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait      types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	DefaultLabels     types.Map     `tfsdk:"default_labels"`
+}
+
+// providerData is what the provider passes to data sources and resources.
+type providerData struct {
+	client *client.APIWrapper
+	// defaultLabels are merged into the labels of every resource that has labels.
+	defaultLabels map[string]string
 }
 
+const (
+	// defaultMaxRetries is the number of times failed API requests are retried.
+	defaultMaxRetries = 3
+	// defaultRetryMaxWait is the longest wait between two attempts, in seconds.
+	defaultRetryMaxWait = 30
+)
+
+// Default timeouts of resource operations, which the timeouts block of every
+// resource overrides.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
 // Metadata returns the provider type name.
 func (p *quicknodeProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "quicknode"
@@ -60,6 +94,39 @@ func (p *quicknodeProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The number of times API requests that were rate limited or failed with a server error are retried. Defaults to 3, 0 disables retries.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "The longest time to wait between two attempts of a request, in seconds. Waits requested by the API with a Retry-After header are capped to it too. Defaults to 30.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "The number of API requests per second the provider sends at most, e.g. 0.5 for one request every two seconds. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
+			"default_labels": schema.MapAttribute{
+				Description: "Labels such as team, environment or cost-center merged into the labels of the quicknode_destination, quicknode_endpoint, " +
+					"quicknode_function, quicknode_ipfs_pin, quicknode_notification and quicknode_stream resources. Labels set on a resource take precedence. " +
+					"Keys and values may only contain lowercase letters, digits, underscores and hyphens. " +
+					"Other resources have no labels: quicknode_kv_list and quicknode_kv_set only store keys and values, with no name or metadata to hold labels; " +
+					"the endpoint tokens, referrers, IPs, domain masks, JWTs, rate limits and add-ons are settings of their endpoint, which holds the labels; " +
+					"the name of a quicknode_gateway is its subdomain, which cannot hold the encoded labels; " +
+					"and the upload API of quicknode_ipfs_file takes no metadata.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  labelsValidators,
+			},
 		},
 	}
 }
@@ -94,6 +161,14 @@ func (p *quicknodeProvider) Configure(ctx context.Context, req provider.Configur
 				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_PASSWORD environment variable.")
 	}
 
+	if config.DefaultLabels.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_labels"),
+			"Unknown QuickNode Default Labels",
+			"The provider cannot merge the default labels into the labels of resources as they are unknown. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.")
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -137,12 +212,41 @@ func (p *quicknodeProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	maxRetries := int64(defaultMaxRetries)
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	retryMaxWait := int64(defaultRetryMaxWait)
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = config.RetryMaxWait.ValueInt64()
+	}
+
 	tflog.Debug(ctx, "Creating QuickNode client")
 	tf_client := client.NewAPIWrapper(token, host)
 
+	// retry rate limited and failed requests of every API client
+	transport := &api.RetryTransport{
+		Next:       tf_client.Client.Transport,
+		MaxRetries: int(maxRetries),
+		MaxWait:    time.Duration(retryMaxWait) * time.Second,
+	}
+	if !config.RequestsPerSecond.IsNull() {
+		requestsPerSecond := config.RequestsPerSecond.ValueFloat64()
+		transport.Limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), max(1, int(requestsPerSecond)))
+	}
+	tf_client.Client.Transport = transport
+
+	var defaultLabels map[string]string
+	resp.Diagnostics.Append(config.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// make the quicknode api client available during data source and resource
-	resp.DataSourceData = tf_client
-	resp.ResourceData = tf_client
+	data := &providerData{client: tf_client, defaultLabels: defaultLabels}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 
 	tflog.Info(ctx, "Configured QuickNode client", map[string]any{"success": true})
 }
@@ -156,6 +260,10 @@ func (p *quicknodeProvider) DataSources(_ context.Context) []func() datasource.D
 		NewNotificationDataSource,
 		NewGatewayDataSource,
 		NewGatewaysDataSource,
+		NewEndpointsDataSource,
+		NewChainsDataSource,
+		NewAddonsDataSource,
+		NewAlertExpressionDataSource,
 	}
 }
 
@@ -165,5 +273,20 @@ func (p *quicknodeProvider) Resources(_ context.Context) []func() resource.Resou
 		NewDestinationResource,
 		NewNotificationResource,
 		NewGatewayResource,
+		NewEndpointResource,
+		NewEndpointTokenResource,
+		NewEndpointReferrerResource,
+		NewEndpointIPResource,
+		NewEndpointDomainMaskResource,
+		NewEndpointJWTResource,
+		NewEndpointRateLimitsResource,
+		NewEndpointMethodRateLimitResource,
+		NewEndpointAddonResource,
+		NewStreamResource,
+		NewFunctionResource,
+		NewIPFSPinResource,
+		NewIPFSFileResource,
+		NewKVListResource,
+		NewKVSetResource,
 	}
 }
@@ -1,8 +1,18 @@
 package provider
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jmtx1020/go_quicknode/client"
 )
 
 const (
@@ -24,3 +34,56 @@ var (
 		"quicknode": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// notFoundTransport answers every request with a 404, as the API does for
+// objects deleted outside Terraform.
+type notFoundTransport struct{}
+
+func (notFoundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"message":"not found"}`)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+// testResourceNotFound checks that a resource whose object no longer exists
+// is removed from state by Read and that deleting it succeeds, without
+// network access.
+func testResourceNotFound(t *testing.T, r resource.Resource, values map[string]tftypes.Value) {
+	t.Helper()
+	ctx := context.Background()
+
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: &providerData{client: &client.APIWrapper{Client: &http.Client{Transport: notFoundTransport{}}}},
+	}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+
+	readResp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.IsNull() {
+		t.Errorf("expected the resource to be removed from state")
+	}
+
+	deleteResp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+	}
+}
@@ -0,0 +1,750 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jmtx1020/go_quicknode/client"
+
+	"terraform-provider-quicknode/internal/api"
+	"terraform-provider-quicknode/internal/api/streams"
+)
+
+var (
+	_ resource.Resource                     = &streamResource{}
+	_ resource.ResourceWithConfigure        = &streamResource{}
+	_ resource.ResourceWithImportState      = &streamResource{}
+	_ resource.ResourceWithConfigValidators = &streamResource{}
+	_ resource.ResourceWithModifyPlan       = &streamResource{}
+)
+
+type streamResource struct {
+	client        *client.APIWrapper
+	defaultLabels map[string]string
+}
+
+func NewStreamResource() resource.Resource {
+	return &streamResource{}
+}
+
+type streamResourceModel struct {
+	ID               types.String          `tfsdk:"id"`
+	Name             types.String          `tfsdk:"name"`
+	Network          types.String          `tfsdk:"network"`
+	Dataset          types.String          `tfsdk:"dataset"`
+	StartRange       types.Int64           `tfsdk:"start_range"`
+	EndRange         types.Int64           `tfsdk:"end_range"`
+	FilterFunction   types.String          `tfsdk:"filter_function"`
+	DatasetBatchSize types.Int64           `tfsdk:"dataset_batch_size"`
+	Region           types.String          `tfsdk:"region"`
+	Status           types.String          `tfsdk:"status"`
+	Webhook          *streamWebhookModel   `tfsdk:"webhook"`
+	S3               *streamS3Model        `tfsdk:"s3"`
+	Postgres         *streamPostgresModel  `tfsdk:"postgres"`
+	Snowflake        *streamSnowflakeModel `tfsdk:"snowflake"`
+	CreatedAt        timestampValue        `tfsdk:"created_at"`
+	UpdatedAt        timestampValue        `tfsdk:"updated_at"`
+	Labels           types.Map             `tfsdk:"labels"`
+	LabelsAll        types.Map             `tfsdk:"labels_all"`
+	Timeouts         timeouts.Value        `tfsdk:"timeouts"`
+}
+
+type streamWebhookModel struct {
+	URL              types.String `tfsdk:"url"`
+	Compression      types.String `tfsdk:"compression"`
+	Headers          types.Map    `tfsdk:"headers"`
+	MaxRetry         types.Int64  `tfsdk:"max_retry"`
+	RetryIntervalSec types.Int64  `tfsdk:"retry_interval_sec"`
+	PostTimeoutSec   types.Int64  `tfsdk:"post_timeout_sec"`
+}
+
+type streamS3Model struct {
+	Endpoint            types.String `tfsdk:"endpoint"`
+	Bucket              types.String `tfsdk:"bucket"`
+	Region              types.String `tfsdk:"region"`
+	AccessKey           types.String `tfsdk:"access_key"`
+	SecretKey           types.String `tfsdk:"secret_key"`
+	Prefix              types.String `tfsdk:"prefix"`
+	FileType            types.String `tfsdk:"file_type"`
+	FileCompressionType types.String `tfsdk:"file_compression_type"`
+	UseSSL              types.Bool   `tfsdk:"use_ssl"`
+	MaxRetry            types.Int64  `tfsdk:"max_retry"`
+	RetryIntervalSec    types.Int64  `tfsdk:"retry_interval_sec"`
+}
+
+type streamPostgresModel struct {
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	Database         types.String `tfsdk:"database"`
+	Username         types.String `tfsdk:"username"`
+	Password         types.String `tfsdk:"password"`
+	TableName        types.String `tfsdk:"table_name"`
+	SSLMode          types.String `tfsdk:"sslmode"`
+	MaxRetry         types.Int64  `tfsdk:"max_retry"`
+	RetryIntervalSec types.Int64  `tfsdk:"retry_interval_sec"`
+}
+
+type streamSnowflakeModel struct {
+	Account          types.String `tfsdk:"account"`
+	Database         types.String `tfsdk:"database"`
+	Schema           types.String `tfsdk:"schema"`
+	Warehouse        types.String `tfsdk:"warehouse"`
+	Username         types.String `tfsdk:"username"`
+	Password         types.String `tfsdk:"password"`
+	TableName        types.String `tfsdk:"table_name"`
+	MaxRetry         types.Int64  `tfsdk:"max_retry"`
+	RetryIntervalSec types.Int64  `tfsdk:"retry_interval_sec"`
+}
+
+// streamAttributes collects the attributes of a destination block that are set,
+// leaving out the ones the API should default.
+type streamAttributes map[string]any
+
+func (a streamAttributes) setString(key string, v types.String) {
+	if !v.IsNull() && !v.IsUnknown() {
+		a[key] = v.ValueString()
+	}
+}
+
+func (a streamAttributes) setInt64(key string, v types.Int64) {
+	if !v.IsNull() && !v.IsUnknown() {
+		a[key] = v.ValueInt64()
+	}
+}
+
+func (a streamAttributes) setBool(key string, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		a[key] = v.ValueBool()
+	}
+}
+
+// destination returns the destination type and attributes of the configured destination block.
+func (m streamResourceModel) destination(ctx context.Context) (string, map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attrs := streamAttributes{}
+
+	switch {
+	case m.Webhook != nil:
+		attrs.setString("url", m.Webhook.URL)
+		attrs.setString("compression", m.Webhook.Compression)
+		attrs.setInt64("max_retry", m.Webhook.MaxRetry)
+		attrs.setInt64("retry_interval_sec", m.Webhook.RetryIntervalSec)
+		attrs.setInt64("post_timeout_sec", m.Webhook.PostTimeoutSec)
+		if !m.Webhook.Headers.IsNull() && !m.Webhook.Headers.IsUnknown() {
+			headers := map[string]string{}
+			diags = m.Webhook.Headers.ElementsAs(ctx, &headers, false)
+			attrs["headers"] = headers
+		}
+		return "webhook", attrs, diags
+	case m.S3 != nil:
+		attrs.setString("endpoint", m.S3.Endpoint)
+		attrs.setString("bucket", m.S3.Bucket)
+		attrs.setString("region", m.S3.Region)
+		attrs.setString("access_key", m.S3.AccessKey)
+		attrs.setString("secret_key", m.S3.SecretKey)
+		attrs.setString("prefix", m.S3.Prefix)
+		attrs.setString("file_type", m.S3.FileType)
+		attrs.setString("file_compression_type", m.S3.FileCompressionType)
+		attrs.setBool("use_ssl", m.S3.UseSSL)
+		attrs.setInt64("max_retry", m.S3.MaxRetry)
+		attrs.setInt64("retry_interval_sec", m.S3.RetryIntervalSec)
+		return "s3", attrs, diags
+	case m.Postgres != nil:
+		attrs.setString("host", m.Postgres.Host)
+		attrs.setInt64("port", m.Postgres.Port)
+		attrs.setString("database", m.Postgres.Database)
+		attrs.setString("username", m.Postgres.Username)
+		attrs.setString("password", m.Postgres.Password)
+		attrs.setString("table_name", m.Postgres.TableName)
+		attrs.setString("sslmode", m.Postgres.SSLMode)
+		attrs.setInt64("max_retry", m.Postgres.MaxRetry)
+		attrs.setInt64("retry_interval_sec", m.Postgres.RetryIntervalSec)
+		return "postgres", attrs, diags
+	case m.Snowflake != nil:
+		attrs.setString("account", m.Snowflake.Account)
+		attrs.setString("database", m.Snowflake.Database)
+		attrs.setString("schema", m.Snowflake.Schema)
+		attrs.setString("warehouse", m.Snowflake.Warehouse)
+		attrs.setString("username", m.Snowflake.Username)
+		attrs.setString("password", m.Snowflake.Password)
+		attrs.setString("table_name", m.Snowflake.TableName)
+		attrs.setInt64("max_retry", m.Snowflake.MaxRetry)
+		attrs.setInt64("retry_interval_sec", m.Snowflake.RetryIntervalSec)
+		return "snowflake", attrs, diags
+	}
+
+	return "", attrs, diags
+}
+
+// payload converts the model to the API payload, base64 encoding the filter
+// function and encoding the labels in the name.
+func (m streamResourceModel) payload(ctx context.Context) (streams.StreamPayload, diag.Diagnostics) {
+	destination, attributes, diags := m.destination(ctx)
+	name, nameDiags := encodeLabelsValue(ctx, m.Name.ValueString(), m.LabelsAll)
+	diags.Append(nameDiags...)
+
+	payload := streams.StreamPayload{
+		Name:                  name,
+		Network:               m.Network.ValueString(),
+		Dataset:               m.Dataset.ValueString(),
+		Region:                m.Region.ValueString(),
+		StartRange:            m.StartRange.ValueInt64(),
+		EndRange:              -1,
+		DatasetBatchSize:      m.DatasetBatchSize.ValueInt64(),
+		Destination:           destination,
+		DestinationAttributes: attributes,
+	}
+	if !m.EndRange.IsNull() {
+		payload.EndRange = m.EndRange.ValueInt64()
+	}
+	if !m.FilterFunction.IsNull() {
+		payload.FilterFunction = base64.StdEncoding.EncodeToString([]byte(m.FilterFunction.ValueString()))
+	}
+	return payload, diags
+}
+
+// Configure adds the provider configured client to the resource.
+func (s *streamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = data.client
+	s.defaultLabels = data.defaultLabels
+}
+
+// Metadata returns the resource type name.
+func (s *streamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream"
+}
+
+func (s *streamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	retryAttributes := func(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+		attributes["max_retry"] = schema.Int64Attribute{
+			Description: "The number of times delivery is retried before the stream is terminated.",
+			Optional:    true,
+		}
+		attributes["retry_interval_sec"] = schema.Int64Attribute{
+			Description: "The number of seconds to wait between retries.",
+			Optional:    true,
+		}
+		return attributes
+	}
+
+	resp.Schema = schema.Schema{
+		Description: `Manages a QuickNode Stream. Exactly one of the webhook, s3, postgres or snowflake blocks must be set.
+		Credentials in the destination blocks are not returned by the API, so changes made outside Terraform are not detected.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The stream ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the stream.",
+				Required:    true,
+			},
+			"labels":     labelsAttribute("name"),
+			"labels_all": labelsAllAttribute(),
+			"network": schema.StringAttribute{
+				Description: "The network the stream reads from, e.g. \"ethereum-mainnet\". Changing this forces a new stream to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Description: "The dataset the stream delivers, e.g. \"block\", \"receipts\" or \"logs\". Changing this forces a new stream to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_range": schema.Int64Attribute{
+				Description: "The block number the stream starts at.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"end_range": schema.Int64Attribute{
+				Description: "The block number the stream ends at. The stream keeps following the tip of the chain when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"filter_function": schema.StringAttribute{
+				Description: "The body of the JavaScript filter function applied to the dataset before delivery. The provider handles the base64 encoding.",
+				Optional:    true,
+			},
+			"dataset_batch_size": schema.Int64Attribute{
+				Description: "The number of blocks delivered per batch.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The region the stream runs in, e.g. \"usa_east\". Changing this forces a new stream to be created.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("usa_east"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the stream. ENUM: 'active', 'paused'",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "paused"),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType:  timestampType{},
+				Description: "The date and time the stream was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType:  timestampType{},
+				Description: "The date and time the stream was last updated.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+			"webhook": schema.SingleNestedBlock{
+				Description: "Delivers the stream to a webhook.",
+				Attributes: retryAttributes(map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Description: "The URL the stream is posted to.",
+						Required:    true,
+					},
+					"compression": schema.StringAttribute{
+						Description: "The compression of the payload. ENUM: 'none', 'gzip'",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "gzip"),
+						},
+					},
+					"headers": schema.MapAttribute{
+						Description: "Additional headers sent with every request.",
+						Optional:    true,
+						Sensitive:   true,
+						ElementType: types.StringType,
+					},
+					"post_timeout_sec": schema.Int64Attribute{
+						Description: "The number of seconds to wait for the webhook to respond.",
+						Optional:    true,
+					},
+				}),
+			},
+			"s3": schema.SingleNestedBlock{
+				Description: "Delivers the stream to an S3 compatible bucket.",
+				Attributes: retryAttributes(map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description: "The S3 compatible endpoint, e.g. \"s3.amazonaws.com\".",
+						Required:    true,
+					},
+					"bucket": schema.StringAttribute{
+						Description: "The bucket the stream is written to.",
+						Required:    true,
+					},
+					"region": schema.StringAttribute{
+						Description: "The region of the bucket.",
+						Optional:    true,
+					},
+					"access_key": schema.StringAttribute{
+						Description: "The access key used to write to the bucket.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"secret_key": schema.StringAttribute{
+						Description: "The secret key used to write to the bucket.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"prefix": schema.StringAttribute{
+						Description: "The prefix of the objects written to the bucket.",
+						Optional:    true,
+					},
+					"file_type": schema.StringAttribute{
+						Description: "The file type of the objects. ENUM: '.json'",
+						Optional:    true,
+					},
+					"file_compression_type": schema.StringAttribute{
+						Description: "The compression of the objects. ENUM: 'none', 'gzip'",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "gzip"),
+						},
+					},
+					"use_ssl": schema.BoolAttribute{
+						Description: "Whether the endpoint is reached over TLS.",
+						Optional:    true,
+					},
+				}),
+			},
+			"postgres": schema.SingleNestedBlock{
+				Description: "Delivers the stream to a PostgreSQL table.",
+				Attributes: retryAttributes(map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						Description: "The host of the database.",
+						Required:    true,
+					},
+					"port": schema.Int64Attribute{
+						Description: "The port of the database.",
+						Optional:    true,
+					},
+					"database": schema.StringAttribute{
+						Description: "The name of the database.",
+						Required:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "The user the stream connects as.",
+						Required:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The password of the user.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"table_name": schema.StringAttribute{
+						Description: "The table the stream is written to.",
+						Required:    true,
+					},
+					"sslmode": schema.StringAttribute{
+						Description: "The SSL mode of the connection. ENUM: 'disable', 'require'",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("disable", "require"),
+						},
+					},
+				}),
+			},
+			"snowflake": schema.SingleNestedBlock{
+				Description: "Delivers the stream to a Snowflake table.",
+				Attributes: retryAttributes(map[string]schema.Attribute{
+					"account": schema.StringAttribute{
+						Description: "The Snowflake account identifier.",
+						Required:    true,
+					},
+					"database": schema.StringAttribute{
+						Description: "The name of the database.",
+						Required:    true,
+					},
+					"schema": schema.StringAttribute{
+						Description: "The schema of the table.",
+						Optional:    true,
+					},
+					"warehouse": schema.StringAttribute{
+						Description: "The warehouse used to load the data.",
+						Optional:    true,
+					},
+					"username": schema.StringAttribute{
+						Description: "The user the stream connects as.",
+						Required:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The password of the user.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"table_name": schema.StringAttribute{
+						Description: "The table the stream is written to.",
+						Required:    true,
+					},
+				}),
+			},
+		},
+	}
+}
+
+// ConfigValidators ensures exactly one destination block is configured.
+func (s *streamResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("webhook"),
+			path.MatchRoot("s3"),
+			path.MatchRoot("postgres"),
+			path.MatchRoot("snowflake"),
+		),
+	}
+}
+
+// ModifyPlan merges the default labels.
+func (s *streamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanLabels(ctx, s.defaultLabels, req, resp)
+}
+
+func (s *streamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan streamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	streamAPI := &streams.StreamAPI{API: api.WithContext(ctx, s.client)}
+	stream, err := streamAPI.CreateStream(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating stream",
+			"Could not create stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(stream.ID)
+	plan.CreatedAt = newTimestampValue(stream.CreatedAt)
+	plan.UpdatedAt = newTimestampValue(stream.UpdatedAt)
+
+	// activate or pause the stream based on plan values
+	if stream.Status != plan.Status.ValueString() {
+		// the stream exists from here on, so save it with the status it was
+		// created with to avoid orphaning it if the toggle fails
+		created := plan
+		created.Status = types.StringValue(stream.Status)
+		resp.Diagnostics.Append(resp.State.Set(ctx, created)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "Toggling Stream", map[string]any{"status": plan.Status.ValueString()})
+		err = streamAPI.ToggleStreamByID(stream.ID, plan.Status.ValueString() == "active")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error toggling stream",
+				"Could not set stream status to "+plan.Status.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *streamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state streamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	streamAPI := &streams.StreamAPI{API: api.WithContext(ctx, s.client)}
+	stream, err := streamAPI.GetStreamByID(state.ID.ValueString())
+	if err != nil {
+		// deleted outside Terraform, so plan to create it again
+		if api.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Stream",
+			"Could not read QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	name, labels := decodeLabels(stream.Name)
+	state.Name = types.StringValue(name)
+	readLabels(s.defaultLabels, labels, &state.Labels, &state.LabelsAll)
+	state.Network = types.StringValue(stream.Network)
+	state.Dataset = types.StringValue(stream.Dataset)
+	state.Region = types.StringValue(stream.Region)
+	state.StartRange = types.Int64Value(stream.StartRange)
+	state.DatasetBatchSize = types.Int64Value(stream.DatasetBatchSize)
+	state.Status = types.StringValue(stream.Status)
+	state.CreatedAt = newTimestampValue(stream.CreatedAt)
+	state.UpdatedAt = newTimestampValue(stream.UpdatedAt)
+
+	// streams without an end follow the tip of the chain and report -1
+	if stream.EndRange >= 0 {
+		state.EndRange = types.Int64Value(stream.EndRange)
+	} else {
+		state.EndRange = types.Int64Null()
+	}
+
+	if stream.FilterFunction != "" {
+		filterFunction, err := base64.StdEncoding.DecodeString(stream.FilterFunction)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading QuickNode Stream",
+				"Could not decode filter function of QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		state.FilterFunction = types.StringValue(string(filterFunction))
+	} else {
+		state.FilterFunction = types.StringNull()
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *streamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan streamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state streamResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	streamAPI := &streams.StreamAPI{API: api.WithContext(ctx, s.client)}
+	stream, err := streamAPI.UpdateStreamByID(state.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating QuickNode Stream.",
+			"Could not update QuickNode ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// activate or pause the stream based on plan values
+	if stream.Status != plan.Status.ValueString() {
+		tflog.Debug(ctx, "Toggling Stream", map[string]any{"status": plan.Status.ValueString()})
+		err = streamAPI.ToggleStreamByID(state.ID.ValueString(), plan.Status.ValueString() == "active")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error toggling stream",
+				"Could not set stream status to "+plan.Status.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.CreatedAt = newTimestampValue(stream.CreatedAt)
+	plan.UpdatedAt = newTimestampValue(stream.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *streamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state streamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	streamAPI := &streams.StreamAPI{API: api.WithContext(ctx, s.client)}
+	// A stream that is already gone counts as deleted
+	err := streamAPI.DeleteStreamByID(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Stream "+state.ID.ValueString(),
+			"Could not delete stream, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (s *streamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
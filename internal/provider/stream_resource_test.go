@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jmtx1020/go_quicknode/client"
+)
+
+func TestStreamResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan time validation of the destination blocks
+			{
+				Config: providerConfig + `
+				resource "quicknode_stream" "test" {
+					name        = "tf-testing-stream"
+					network     = "ethereum-mainnet"
+					dataset     = "block"
+					start_range = 19000000
+				}
+				`,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_stream" "test" {
+					name            = "tf-testing-stream"
+					network         = "ethereum-mainnet"
+					dataset         = "block"
+					start_range     = 19000000
+					end_range       = 19000010
+					filter_function = "function main(data) { return data; }"
+					status          = "paused"
+
+					webhook {
+						url = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_stream.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_stream.test", "name", "tf-testing-stream"),
+					resource.TestCheckResourceAttr("quicknode_stream.test", "filter_function", "function main(data) { return data; }"),
+					resource.TestCheckResourceAttr("quicknode_stream.test", "status", "paused"),
+					resource.TestCheckResourceAttr("quicknode_stream.test", "dataset_batch_size", "1"),
+					resource.TestCheckResourceAttr("quicknode_stream.test", "region", "usa_east"),
+					resource.TestCheckResourceAttrSet("quicknode_stream.test", "created_at"),
+					resource.TestCheckResourceAttrSet("quicknode_stream.test", "updated_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "quicknode_stream.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+				resource "quicknode_stream" "test" {
+					name            = "tf-testing-stream-update"
+					network         = "ethereum-mainnet"
+					dataset         = "block"
+					start_range     = 19000000
+					end_range       = 19000010
+					filter_function = "function main(data) { return data; }"
+					status          = "active"
+
+					webhook {
+						url = "https://us-central1-serious-truck-412423.cloudfunctions.net/function-1"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_stream.test", "name", "tf-testing-stream-update"),
+					resource.TestCheckResourceAttr("quicknode_stream.test", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+// toggleFailureTransport creates paused streams and fails to toggle them.
+type toggleFailureTransport struct{}
+
+func (toggleFailureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status, body := http.StatusOK, `{"id":"stream-1","status":"paused","created_at":"2024-01-02T03:04:05Z","updated_at":"2024-01-02T03:04:05Z"}`
+	if strings.HasSuffix(req.URL.Path, "/activate") {
+		status, body = http.StatusInternalServerError, `{"message":"internal error"}`
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func TestStreamResourceCreateToggleFailure(t *testing.T) {
+	ctx := context.Background()
+	r := &streamResource{client: &client.APIWrapper{Client: &http.Client{Transport: toggleFailureTransport{}}}}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["name"] = tftypes.NewValue(tftypes.String, "transfers")
+	attributes["status"] = tftypes.NewValue(tftypes.String, "active")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected the toggle error to be reported")
+	}
+
+	// the created stream is kept in state, so Terraform taints it instead of
+	// creating a duplicate
+	for attribute, want := range map[string]string{"id": "stream-1", "status": "paused"} {
+		var got types.String
+		resp.State.GetAttribute(ctx, path.Root(attribute), &got)
+		if got.ValueString() != want {
+			t.Errorf("%s = %q, want %q", attribute, got.ValueString(), want)
+		}
+	}
+}
+
+func TestStreamResourceNotFound(t *testing.T) {
+	testResourceNotFound(t, NewStreamResource(), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "stream-1"),
+	})
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = timestampType{}
+	_ basetypes.StringValuableWithSemanticEquals = timestampValue{}
+)
+
+// legacyTimestampFormat is the format timestamps were stored in before they
+// were RFC3339. It has no time zone; the API returns UTC times.
+const legacyTimestampFormat = "2006-01-02 15:04:05"
+
+// timestampType is a string holding an RFC3339 timestamp.
+type timestampType struct {
+	basetypes.StringType
+}
+
+func (t timestampType) Equal(o attr.Type) bool {
+	other, ok := o.(timestampType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t timestampType) String() string {
+	return "timestampType"
+}
+
+func (t timestampType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return timestampValue{StringValue: in}, nil
+}
+
+func (t timestampType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+func (t timestampType) ValueType(_ context.Context) attr.Value {
+	return timestampValue{}
+}
+
+// timestampValue is an RFC3339 timestamp. Two values are semantically equal
+// when they are the same instant, whatever their time zone.
+type timestampValue struct {
+	basetypes.StringValue
+}
+
+// newTimestampValue formats t as RFC3339. The zero time, which the API
+// returns for timestamps that are not set, is null.
+func newTimestampValue(t time.Time) timestampValue {
+	if t.IsZero() {
+		return timestampValue{StringValue: basetypes.NewStringNull()}
+	}
+	return timestampValue{StringValue: basetypes.NewStringValue(t.Format(time.RFC3339))}
+}
+
+func (v timestampValue) Equal(o attr.Value) bool {
+	other, ok := o.(timestampValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v timestampValue) Type(_ context.Context) attr.Type {
+	return timestampType{}
+}
+
+func (v timestampValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(timestampValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldTime, err := time.Parse(time.RFC3339, v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newTime, err := time.Parse(time.RFC3339, newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return oldTime.Equal(newTime), diags
+}
+
+// upgradeTimestamp converts a timestamp of a prior state to RFC3339. Values
+// that are neither RFC3339 nor in the legacy format are kept as they are.
+func upgradeTimestamp(value string) string {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	if t, err := time.Parse(legacyTimestampFormat, value); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	return value
+}
+
+// timestampStateUpgraders upgrades the version 0 state of a resource, whose
+// timestamp attributes were not RFC3339. Nothing else changed between
+// versions, so the raw state is rewritten as is.
+func timestampStateUpgraders(attributes ...string) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				// numbers are kept as they are rather than as float64
+				decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+				decoder.UseNumber()
+
+				var rawState map[string]any
+				if err := decoder.Decode(&rawState); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade Resource State",
+						"Could not read the prior resource state: "+err.Error(),
+					)
+					return
+				}
+
+				for _, attribute := range attributes {
+					if value, ok := rawState[attribute].(string); ok {
+						rawState[attribute] = upgradeTimestamp(value)
+					}
+				}
+
+				upgraded, err := json.Marshal(rawState)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Upgrade Resource State",
+						"Could not write the upgraded resource state: "+err.Error(),
+					)
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
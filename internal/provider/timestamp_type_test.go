@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestTimestampSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{"identical", "2024-03-01T12:30:00Z", "2024-03-01T12:30:00Z", true},
+		{"other time zone", "2024-03-01T12:30:00Z", "2024-03-01T13:30:00+01:00", true},
+		{"different instant", "2024-03-01T12:30:00Z", "2024-03-01T12:30:01Z", false},
+		{"legacy format", "2024-03-01T12:30:00Z", "2024-03-01 12:30:00", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			equal, diags := timestampValue{StringValue: types.StringValue(tt.old)}.StringSemanticEquals(context.Background(), timestampValue{StringValue: types.StringValue(tt.new)})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tt.expected {
+				t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tt.old, tt.new, equal, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewTimestampValue(t *testing.T) {
+	if value := newTimestampValue(time.Time{}); !value.IsNull() {
+		t.Errorf("newTimestampValue(zero) = %s, want null", value)
+	}
+
+	created := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	if value := newTimestampValue(created); value.ValueString() != "2024-03-01T12:30:00Z" {
+		t.Errorf("newTimestampValue() = %s", value)
+	}
+}
+
+func TestTimestampStateUpgraders(t *testing.T) {
+	upgrader := timestampStateUpgraders("created_at", "updated_at")[0]
+
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":"1","block":9007199254740993,"created_at":"2024-03-01 12:30:00","updated_at":"2024-03-02T08:00:00.123Z"}`),
+		},
+	}
+	var resp resource.UpgradeStateResponse
+	upgrader.StateUpgrader(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var upgraded map[string]json.RawMessage
+	if err := json.Unmarshal(resp.DynamicValue.JSON, &upgraded); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"id":         `"1"`,
+		"block":      `9007199254740993`,
+		"created_at": `"2024-03-01T12:30:00Z"`,
+		"updated_at": `"2024-03-02T08:00:00Z"`,
+	}
+	for attribute, value := range expected {
+		if string(upgraded[attribute]) != value {
+			t.Errorf("%s = %s, want %s", attribute, upgraded[attribute], value)
+		}
+	}
+}
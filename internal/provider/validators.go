@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// quickAlertsNetworkRegexp matches QuickAlerts networks such as
+// "ethereum-mainnet". Networks are not listed, so that networks added to
+// QuickAlerts can be used without a provider release; quicknode_chains
+// exposes them as quickalerts_network.
+var quickAlertsNetworkRegexp = regexp.MustCompile(`^[a-z0-9]+-[a-z0-9-]+$`)
+
+// gatewayNameRegexp matches gateway names, which are used as subdomains.
+var gatewayNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+var _ validator.String = urlValidator{}
+
+// urlValidator validates that a string is an absolute http or https URL.
+type urlValidator struct{}
+
+func (v urlValidator) Description(_ context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(req.ConfigValue.ValueString())
+	if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+		err = fmt.Errorf("%q is not an absolute http or https URL", req.ConfigValue.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateAttribute runs the validators of a resource attribute against value
+// without configuring the provider, so it needs no network access.
+func validateAttribute(t *testing.T, r resource.Resource, attr string, value any) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	var diags diag.Diagnostics
+	switch a := schemaResp.Schema.Attributes[attr].(type) {
+	case schema.StringAttribute:
+		for _, v := range a.Validators {
+			resp := &validator.StringResponse{}
+			v.ValidateString(ctx, validator.StringRequest{Path: path.Root(attr), ConfigValue: types.StringValue(value.(string))}, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.Int64Attribute:
+		for _, v := range a.Validators {
+			resp := &validator.Int64Response{}
+			v.ValidateInt64(ctx, validator.Int64Request{Path: path.Root(attr), ConfigValue: types.Int64Value(int64(value.(int)))}, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	case schema.SetAttribute:
+		value, d := types.SetValueFrom(ctx, types.StringType, value)
+		diags.Append(d...)
+		for _, v := range a.Validators {
+			resp := &validator.SetResponse{}
+			v.ValidateSet(ctx, validator.SetRequest{Path: path.Root(attr), ConfigValue: value}, resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	default:
+		t.Fatalf("unsupported attribute %s of type %T", attr, a)
+	}
+	return diags
+}
+
+// testPublicKey is a PEM encoded P-256 public key.
+const testPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEnlU9y0rpXUhBH25jATLEOx1c5KPi
+qoUBtgoUAnjZFOEg+vuI0QkemIrIFZ3GrLPPSi/oUNJdqnpewXxH3ZeUQA==
+-----END PUBLIC KEY-----
+`
+
+func TestResourceValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource resource.Resource
+		attr     string
+		value    any
+		valid    bool
+	}{
+		{"destination POST webhook", NewDestinationResource(), "webhook_type", "POST", true},
+		{"destination GET webhook", NewDestinationResource(), "webhook_type", "GET", true},
+		{"destination lowercase webhook", NewDestinationResource(), "webhook_type", "post", false},
+		{"destination webhook service", NewDestinationResource(), "service", "webhook", true},
+		{"destination email service", NewDestinationResource(), "service", "email", false},
+		{"destination lowest payload type", NewDestinationResource(), "payload_type", 1, true},
+		{"destination highest payload type", NewDestinationResource(), "payload_type", 7, true},
+		{"destination payload type 0", NewDestinationResource(), "payload_type", 0, false},
+		{"destination payload type 8", NewDestinationResource(), "payload_type", 8, false},
+		{"destination https url", NewDestinationResource(), "to", "https://example.com/hook", true},
+		{"destination http url", NewDestinationResource(), "to", "http://localhost:8080/hook", true},
+		{"destination relative url", NewDestinationResource(), "to", "/hook", false},
+		{"destination ftp url", NewDestinationResource(), "to", "ftp://example.com/hook", false},
+		{"destination malformed url", NewDestinationResource(), "to", "https://exa mple.com/%zz", false},
+		{"notification network", NewNotificationResource(), "network", "ethereum-mainnet", true},
+		{"notification network added later", NewNotificationResource(), "network", "newchain-mainnet-beta", true},
+		{"notification network without chain", NewNotificationResource(), "network", "ethereum", false},
+		{"notification uppercase network", NewNotificationResource(), "network", "Ethereum-Mainnet", false},
+		{"notification destination ids", NewNotificationResource(), "destination_ids", []string{"a", "b"}, true},
+		{"notification empty destination id", NewNotificationResource(), "destination_ids", []string{"a", ""}, false},
+		{"jwt public key", NewEndpointJWTResource(), "public_key", testPublicKey, true},
+		{"jwt public key without pem", NewEndpointJWTResource(), "public_key", "not a pem", false},
+		{"jwt private key", NewEndpointJWTResource(), "public_key", strings.ReplaceAll(testPublicKey, "PUBLIC", "PRIVATE"), false},
+		{"jwt truncated public key", NewEndpointJWTResource(), "public_key", strings.Replace(testPublicKey, "qoUBtgoUAnjZFOEg", "", 1), false},
+		{"method rate limit methods", NewEndpointMethodRateLimitResource(), "methods", []string{"eth_getLogs", "trace_block"}, true},
+		{"method rate limit no methods", NewEndpointMethodRateLimitResource(), "methods", []string{}, false},
+		{"method rate limit empty method", NewEndpointMethodRateLimitResource(), "methods", []string{"eth_getLogs", ""}, false},
+		{"gateway name", NewGatewayResource(), "name", "my-gateway-1", true},
+		{"gateway single character name", NewGatewayResource(), "name", "a", true},
+		{"gateway uppercase name", NewGatewayResource(), "name", "My-Gateway", false},
+		{"gateway name with leading hyphen", NewGatewayResource(), "name", "-gateway", false},
+		{"gateway name with trailing hyphen", NewGatewayResource(), "name", "gateway-", false},
+		{"gateway name with dot", NewGatewayResource(), "name", "my.gateway", false},
+		{"gateway empty name", NewGatewayResource(), "name", "", false},
+		{"gateway name too long", NewGatewayResource(), "name", "a123456789012345678901234567890123456789012345678901234567890123", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateAttribute(t, tt.resource, tt.attr, tt.value)
+			if diags.HasError() == tt.valid {
+				t.Errorf("validating %s = %v: got errors %v, want valid %t", tt.attr, tt.value, diags, tt.valid)
+			}
+		})
+	}
+}